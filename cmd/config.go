@@ -1,12 +1,19 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"jadwalsalat/config"
+	"jadwalsalat/format"
+	"jadwalsalat/geocode"
+	"jadwalsalat/i18n"
 	"jadwalsalat/salat"
+	"jadwalsalat/theme"
 
 	"github.com/spf13/cobra"
 )
@@ -23,8 +30,8 @@ var configShowCmd = &cobra.Command{
 	Use:   "show",
 	Short: "Tampilkan konfigurasi saat ini",
 	Long:  `Tampilkan konfigurasi aplikasi salat saat ini.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		showConfig()
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return showConfig()
 	},
 }
 
@@ -38,13 +45,31 @@ Contoh penggunaan:
   salat config set timezone Asia/Jakarta
   salat config set location "Jakarta, Indonesia"
   salat config set location "-6.2,106.8"
+  salat config set --first location "Padang"
   salat config set latitude -6.2
   salat config set longitude 106.8
   salat config set method MWL
-  salat config set geocoding_api photon`,
+  salat config set method auto
+  salat config set language en
+  salat config set geocoding_api photon
+  salat config set geocoding_url https://nominatim.example.org
+  salat config set geocoding_email admin@example.org
+  salat config set geocoding_cache_ttl 168h
+  salat config set adjustments.subuh 2
+  salat config set --profile kantor method Kemenag
+  salat config set smtp.host smtp.example.org
+  salat config set digest.recipients "a@example.org,b@example.org"
+  salat config set display.screen_reader true
+  salat config set display.theme high-contrast
+  salat config set display.clock 12h
+  salat config set display.pasaran true
+  salat config set themes.senja.header "hi-magenta bold"
+  salat config set formats.tmux '{{.Next.Name}} {{.Next.In | short}}'`,
 	Args: cobra.ExactArgs(2),
-	Run: func(cmd *cobra.Command, args []string) {
-		setConfig(args[0], args[1])
+	RunE: func(cmd *cobra.Command, args []string) error {
+		offline, _ := cmd.Flags().GetBool("offline")
+		first, _ := cmd.Flags().GetBool("first")
+		return setConfig(args[0], args[1], offline, first)
 	},
 }
 
@@ -52,55 +77,127 @@ func init() {
 	rootCmd.AddCommand(configCmd)
 	configCmd.AddCommand(configShowCmd)
 	configCmd.AddCommand(configSetCmd)
+	configSetCmd.Flags().Bool("offline", false, "Cari lokasi di gazetteer offline tanpa internet")
+	configSetCmd.Flags().Bool("first", false, "Langsung pakai hasil pencarian lokasi teratas tanpa bertanya")
 }
 
 // showConfig displays the current configuration
-func showConfig() {
+func showConfig() error {
 	// Load configuration
-	cfg, err := config.LoadConfig()
+	cfg, err := config.LoadConfigUnvalidated()
 	if err != nil {
-		fmt.Printf("Error loading configuration: %v\n", err)
-		fmt.Println("Run 'salat setup' to configure the application.")
-		return
+		return loadError(err)
 	}
 
 	// Print configuration
-	fmt.Println("Konfigurasi saat ini:")
+	fmt.Println(i18n.T("Konfigurasi saat ini:"))
+	if cfg.Profile != "" {
+		fmt.Println(i18n.Sprintf("  profil: %s", cfg.Profile))
+	}
 	fmt.Printf("  timezone: %s\n", cfg.Timezone)
 	if cfg.LocationName != "" {
-		fmt.Printf("  lokasi: %s\n", cfg.LocationName)
+		fmt.Println(i18n.Sprintf("  lokasi: %s", cfg.LocationName))
 	}
 	fmt.Printf("  latitude: %.6f\n", cfg.Latitude)
 	fmt.Printf("  longitude: %.6f\n", cfg.Longitude)
-	fmt.Printf("  method: %s\n", cfg.Method)
+	fmt.Printf("  method: %s\n", methodLabel(cfg))
+	for _, name := range salat.AdjustmentNames {
+		if minutes, _ := cfg.Adjustments.Get(name); minutes != 0 {
+			fmt.Printf("  adjustments.%s: %+d\n", name, minutes)
+		}
+	}
+	if cfg.Language != "" {
+		fmt.Printf("  language: %s\n", cfg.Language)
+	}
 	if cfg.GeocodingAPI != "" {
 		fmt.Printf("  geocoding_api: %s\n", cfg.GeocodingAPI)
 	}
+	if cfg.GeocodingURL != "" {
+		fmt.Printf("  geocoding_url: %s\n", cfg.GeocodingURL)
+	}
+	if cfg.GeocodingEmail != "" {
+		fmt.Printf("  geocoding_email: %s\n", cfg.GeocodingEmail)
+	}
+	if cfg.GeocodingCacheTTL != "" {
+		fmt.Printf("  geocoding_cache_ttl: %s\n", cfg.GeocodingCacheTTL)
+	}
+	if cfg.SMTP.Host != "" {
+		fmt.Printf("  smtp.host: %s\n", cfg.SMTP.Host)
+		fmt.Printf("  smtp.port: %d\n", cfg.SMTP.Port)
+		fmt.Printf("  smtp.username: %s\n", cfg.SMTP.Username)
+		if cfg.SMTP.Password != "" {
+			fmt.Println("  smtp.password: ********")
+		}
+		fmt.Printf("  smtp.from: %s\n", cfg.SMTP.From)
+	}
+	if len(cfg.Digest.Recipients) > 0 {
+		fmt.Printf("  digest.recipients: %s\n", strings.Join(cfg.Digest.Recipients, ", "))
+	}
+	if cfg.Display.NoColor {
+		fmt.Println("  display.no_color: true")
+	}
+	if cfg.Display.NoEmoji {
+		fmt.Println("  display.no_emoji: true")
+	}
+	if cfg.Display.ScreenReader {
+		fmt.Println("  display.screen_reader: true")
+	}
+	if cfg.Display.Theme != "" {
+		fmt.Printf("  display.theme: %s\n", cfg.Display.Theme)
+	}
+	if cfg.Display.Clock != "" {
+		fmt.Printf("  display.clock: %s\n", cfg.Display.Clock)
+	}
+	if cfg.Display.Seconds {
+		fmt.Println("  display.seconds: true")
+	}
+	if cfg.Display.Pasaran {
+		fmt.Println("  display.pasaran: true")
+	}
+	for _, name := range cfg.ThemeNames() {
+		for _, role := range theme.Roles {
+			if style, _ := cfg.Themes[name].Get(role); style != "" {
+				fmt.Printf("  themes.%s.%s: %s\n", name, role, style)
+			}
+		}
+	}
+	for _, name := range cfg.FormatNames() {
+		fmt.Printf("  formats.%s: %s\n", name, cfg.Formats[name])
+	}
+	warnInvalidConfig(cfg)
+	return nil
 }
 
 // setConfig sets a configuration value
-func setConfig(key, value string) {
+func setConfig(key, value string, offline, first bool) error {
 	// Load configuration
-	cfg, err := config.LoadConfig()
+	cfg, err := config.LoadConfigUnvalidated()
 	if err != nil {
-		fmt.Printf("Error loading configuration: %v\n", err)
-		fmt.Println("Run 'salat setup' to configure the application.")
-		return
+		return loadError(err)
+	}
+
+	// Lookups for location keys use the offline gazetteer when requested
+	apiType := cfg.GeocodingAPI
+	if apiType == "" {
+		apiType = "nominatim"
+	}
+	if offline {
+		apiType = config.OfflineGeocoder
 	}
 
 	// Update configuration based on key
 	switch strings.ToLower(key) {
 	case "timezone":
+		if _, err := time.LoadLocation(value); err != nil {
+			return &locationError{i18n.Errorf("timezone tidak dikenal: %s (gunakan nama IANA seperti Asia/Jakarta)", value)}
+		}
 		cfg.Timezone = value
-		fmt.Printf("Timezone diatur ke: %s\n", value)
-
-	case "location", "lokasi":
-		// Handle location setting with geocoding
-		apiType := cfg.GeocodingAPI
-		if apiType == "" {
-			apiType = "nominatim"
+		fmt.Println(i18n.Sprintf("Timezone diatur ke: %s", value))
+		if cfg.Latitude != 0 || cfg.Longitude != 0 {
+			warnTimezoneMismatch(value, cfg.Latitude, cfg.Longitude)
 		}
 
+	case "location", "lokasi":
 		// Check if value is coordinates
 		parts := strings.Split(value, ",")
 		var isCoordinates bool
@@ -115,117 +212,259 @@ func setConfig(key, value string) {
 			lat, err1 := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
 			lon, err2 := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
 			if err1 == nil && err2 == nil {
+				if err := checkCoordinates(lat, lon); err != nil {
+					return err
+				}
 				cfg.Latitude = lat
 				cfg.Longitude = lon
 
 				// Try to get location name
 				if name, err := config.ReverseGeocode(apiType, lat, lon); err == nil {
 					cfg.LocationName = name
-					fmt.Printf("Lokasi diatur ke: %s (%.6f, %.6f)\n", name, lat, lon)
+					fmt.Println(i18n.Sprintf("Lokasi diatur ke: %s (%.6f, %.6f)", name, lat, lon))
 				} else {
-					cfg.LocationName = fmt.Sprintf("Lokasi (%.6f, %.6f)", lat, lon)
-					fmt.Printf("Koordinat diatur ke: %.6f, %.6f\n", lat, lon)
+					cfg.LocationName = i18n.Sprintf("Lokasi (%.6f, %.6f)", lat, lon)
+					fmt.Println(i18n.Sprintf("Koordinat diatur ke: %.6f, %.6f", lat, lon))
 				}
 			} else {
-				fmt.Printf("Error: koordinat tidak valid: %v, %v\n", err1, err2)
-				return
+				return &locationError{i18n.Errorf("koordinat tidak valid: %v, %v", err1, err2)}
 			}
 		} else {
 			// Forward geocode
-			fmt.Println("🔍 Mencari koordinat lokasi...")
-			lat, lon, locationName, err := config.ForwardGeocode(apiType, value)
+			fmt.Println(i18n.T("🔍 Mencari koordinat lokasi..."))
+			result, err := resolveLocation(apiType, value, first)
 			if err != nil {
-				fmt.Printf("Error: tidak dapat menemukan lokasi: %v\n", err)
-				return
+				return i18n.Errorf("tidak dapat menemukan lokasi: %w", err)
 			}
-			cfg.Latitude = lat
-			cfg.Longitude = lon
-			cfg.LocationName = locationName
-			fmt.Printf("Lokasi diatur ke: %s (%.6f, %.6f)\n", locationName, lat, lon)
+			cfg.Latitude = result.Latitude
+			cfg.Longitude = result.Longitude
+			cfg.LocationName = result.Name
+			fmt.Println(i18n.Sprintf("Lokasi diatur ke: %s (%.6f, %.6f)", result.Name, result.Latitude, result.Longitude))
+		}
+
+		// Follow the timezone of the new location
+		if tz, err := geocode.Timezone(cfg.Latitude, cfg.Longitude); err == nil && !timezoneMatches(cfg.Timezone, tz) {
+			fmt.Println(i18n.Sprintf("Timezone diperbarui: %s (sebelumnya %s)", tz, cfg.Timezone))
+			cfg.Timezone = tz
 		}
 
 	case "latitude":
 		lat, err := strconv.ParseFloat(value, 64)
+		if err == nil && (lat < -90 || lat > 90) {
+			err = i18n.Errorf("harus di antara -90 dan 90")
+		}
 		if err != nil {
-			fmt.Printf("Error: nilai latitude tidak valid: %v\n", err)
-			return
+			return &locationError{i18n.Errorf("nilai latitude tidak valid: %v", err)}
 		}
 		cfg.Latitude = lat
-		fmt.Printf("Latitude diatur ke: %.6f\n", lat)
+		fmt.Println(i18n.Sprintf("Latitude diatur ke: %.6f", lat))
 
 		// Update location name if we have both coordinates
 		if cfg.Longitude != 0 {
-			apiType := cfg.GeocodingAPI
-			if apiType == "" {
-				apiType = "nominatim"
-			}
 			if name, err := config.ReverseGeocode(apiType, lat, cfg.Longitude); err == nil {
 				cfg.LocationName = name
-				fmt.Printf("Lokasi diperbarui: %s\n", name)
+				fmt.Println(i18n.Sprintf("Lokasi diperbarui: %s", name))
 			}
+			warnTimezoneMismatch(cfg.Timezone, lat, cfg.Longitude)
 		}
 
 	case "longitude":
 		lon, err := strconv.ParseFloat(value, 64)
+		if err == nil && (lon < -180 || lon > 180) {
+			err = i18n.Errorf("harus di antara -180 dan 180")
+		}
 		if err != nil {
-			fmt.Printf("Error: nilai longitude tidak valid: %v\n", err)
-			return
+			return &locationError{i18n.Errorf("nilai longitude tidak valid: %v", err)}
 		}
 		cfg.Longitude = lon
-		fmt.Printf("Longitude diatur ke: %.6f\n", lon)
+		fmt.Println(i18n.Sprintf("Longitude diatur ke: %.6f", lon))
 
 		// Update location name if we have both coordinates
 		if cfg.Latitude != 0 {
-			apiType := cfg.GeocodingAPI
-			if apiType == "" {
-				apiType = "nominatim"
-			}
 			if name, err := config.ReverseGeocode(apiType, cfg.Latitude, lon); err == nil {
 				cfg.LocationName = name
-				fmt.Printf("Lokasi diperbarui: %s\n", name)
+				fmt.Println(i18n.Sprintf("Lokasi diperbarui: %s", name))
 			}
+			warnTimezoneMismatch(cfg.Timezone, cfg.Latitude, lon)
 		}
 
 	case "method":
-		// Validate method
-		validMethods := map[string]bool{
-			string(salat.MWL):     true,
-			string(salat.ISNA):    true,
-			string(salat.Egypt):   true,
-			string(salat.Makkah):  true,
-			string(salat.Karachi): true,
-			string(salat.Tehran):  true,
-			string(salat.Kemenag): true,
-			string(salat.JAKIM):   true,
+		if !config.ValidMethod(value) {
+			return &usageError{i18n.Errorf("metode tidak valid. Pilih salah satu dari: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto")}
 		}
 
-		if !validMethods[value] {
-			fmt.Printf("Error: metode tidak valid. Pilih salah satu dari: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM\n")
-			return
+		cfg.Method = value
+		if value == string(salat.Auto) {
+			fmt.Println(i18n.Sprintf("Metode perhitungan diatur ke: auto (saat ini %s)", config.ResolveMethod(value, cfg.Latitude, cfg.Longitude)))
+		} else {
+			fmt.Println(i18n.Sprintf("Metode perhitungan diatur ke: %s", value))
 		}
 
-		cfg.Method = value
-		fmt.Printf("Metode perhitungan diatur ke: %s\n", value)
+	case "language":
+		l, err := i18n.Parse(value)
+		if err != nil {
+			return &usageError{err}
+		}
+		cfg.Language = string(l)
+		// Confirm in the new language unless --lang chose one for this run
+		if langFlag == "" {
+			i18n.Set(l)
+		}
+		fmt.Println(i18n.Sprintf("Bahasa diatur ke: %s", l.Name()))
 
 	case "geocoding_api":
-		if value != "nominatim" && value != "photon" {
-			fmt.Printf("Error: API tidak valid. Pilih salah satu dari: nominatim, photon\n")
-			return
+		if _, err := geocode.New(value, geocode.Options{}); err != nil {
+			return &usageError{i18n.Errorf("API tidak valid. Pilih salah satu dari: %s", strings.Join(geocode.Providers(), ", "))}
 		}
 		cfg.GeocodingAPI = value
-		fmt.Printf("Geocoding API diatur ke: %s\n", value)
+		fmt.Println(i18n.Sprintf("Geocoding API diatur ke: %s", value))
+
+	case "geocoding_url":
+		if value != "" {
+			if u, err := url.Parse(value); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return &usageError{i18n.Errorf("URL geocoding tidak valid: %s", value)}
+			}
+		}
+		cfg.GeocodingURL = value
+		fmt.Println(i18n.Sprintf("Geocoding URL diatur ke: %s", value))
+
+	case "geocoding_email":
+		cfg.GeocodingEmail = value
+		fmt.Println(i18n.Sprintf("Email kontak geocoding diatur ke: %s", value))
+
+	case "geocoding_cache_ttl":
+		ttl, err := config.GeocodingCacheTTL(value)
+		if err != nil {
+			return &usageError{i18n.Errorf("durasi cache tidak valid (contoh: 720h, atau 0 untuk menonaktifkan): %s", value)}
+		}
+		cfg.GeocodingCacheTTL = value
+		if ttl == 0 {
+			fmt.Println(i18n.T("Cache geocoding dinonaktifkan"))
+		} else {
+			fmt.Println(i18n.Sprintf("Cache geocoding berlaku selama: %s", ttl))
+		}
+
+	case "smtp.host":
+		cfg.SMTP.Host = value
+		fmt.Println(i18n.Sprintf("SMTP host diatur ke: %s", value))
+
+	case "smtp.port":
+		port, err := strconv.Atoi(value)
+		if err != nil || port <= 0 || port > 65535 {
+			return &usageError{i18n.Errorf("port SMTP tidak valid: %s", value)}
+		}
+		cfg.SMTP.Port = port
+		fmt.Println(i18n.Sprintf("SMTP port diatur ke: %d", port))
+
+	case "smtp.username":
+		cfg.SMTP.Username = value
+		fmt.Println(i18n.Sprintf("SMTP username diatur ke: %s", value))
+
+	case "smtp.password":
+		cfg.SMTP.Password = value
+		fmt.Println(i18n.T("SMTP password diatur"))
+
+	case "smtp.from":
+		cfg.SMTP.From = value
+		fmt.Println(i18n.Sprintf("Pengirim email diatur ke: %s", value))
+
+	case "digest.recipients":
+		var recipients []string
+		for _, r := range strings.Split(value, ",") {
+			if r = strings.TrimSpace(r); r != "" {
+				recipients = append(recipients, r)
+			}
+		}
+		cfg.Digest.Recipients = recipients
+		fmt.Println(i18n.Sprintf("Penerima digest diatur ke: %s", strings.Join(recipients, ", ")))
+
+	case "adjustments.imsak", "adjustments.subuh", "adjustments.dzuhur", "adjustments.ashar", "adjustments.maghrib", "adjustments.isya":
+		minutes, err := strconv.Atoi(value)
+		if err != nil {
+			return &usageError{i18n.Errorf("penyesuaian harus berupa jumlah menit (contoh: 2 atau -3): %s", value)}
+		}
+		prayer := strings.TrimPrefix(strings.ToLower(key), "adjustments.")
+		cfg.Adjustments.Set(prayer, minutes)
+		fmt.Println(i18n.Sprintf("Penyesuaian %s diatur ke: %+d menit", prayer, minutes))
+
+	case "display.no_color", "display.no_emoji", "display.screen_reader", "display.seconds", "display.pasaran":
+		on, err := strconv.ParseBool(value)
+		if err != nil {
+			return &usageError{i18n.Errorf("nilai harus true atau false: %s", value)}
+		}
+		switch strings.ToLower(key) {
+		case "display.no_color":
+			cfg.Display.NoColor = on
+		case "display.no_emoji":
+			cfg.Display.NoEmoji = on
+		case "display.seconds":
+			cfg.Display.Seconds = on
+		case "display.pasaran":
+			cfg.Display.Pasaran = on
+		default:
+			cfg.Display.ScreenReader = on
+		}
+		fmt.Println(i18n.Sprintf("%s diatur ke: %t", strings.ToLower(key), on))
+
+	case "display.clock":
+		value = strings.ToLower(value)
+		if !config.ValidClock(value) {
+			return &usageError{i18n.Errorf("format jam tidak valid %q, pilih 12h atau 24h", value)}
+		}
+		cfg.Display.Clock = value
+		fmt.Println(i18n.Sprintf("Format jam diatur ke: %s", value))
+
+	case "display.theme":
+		value = strings.ToLower(value)
+		if _, ok := theme.Lookup(value, cfg.Themes); !ok {
+			return &usageError{i18n.Errorf("tema tidak dikenal %q, pilih salah satu dari: %s", value, strings.Join(theme.Names(cfg.Themes), ", "))}
+		}
+		cfg.Display.Theme = value
+		fmt.Println(i18n.Sprintf("Tema diatur ke: %s", value))
 
 	default:
-		fmt.Printf("Error: kunci konfigurasi tidak valid. Pilih salah satu dari: timezone, location, latitude, longitude, method, geocoding_api\n")
-		return
+		if rest, ok := strings.CutPrefix(strings.ToLower(key), "themes."); ok {
+			if err := setThemeStyle(cfg, rest, value); err != nil {
+				return err
+			}
+			break
+		}
+		name, ok := strings.CutPrefix(strings.ToLower(key), "formats.")
+		if !ok {
+			return &usageError{i18n.Errorf("kunci konfigurasi tidak valid. Pilih salah satu dari: timezone, location, latitude, longitude, method, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, adjustments.<waktu>, smtp.host, smtp.port, smtp.username, smtp.password, smtp.from, digest.recipients, display.no_color, display.no_emoji, display.screen_reader, display.theme, display.clock, display.seconds, display.pasaran, themes.<nama>.<bagian>, formats.<nama>")}
+		}
+		if err := config.ValidateFormatName(name); err != nil {
+			return &usageError{err}
+		}
+		if _, err := format.Parse(name, value); err != nil {
+			return &usageError{i18n.Errorf("template tidak valid: %v", err)}
+		}
+		if cfg.Formats == nil {
+			cfg.Formats = map[string]string{}
+		}
+		cfg.Formats[name] = value
+		fmt.Println(i18n.Sprintf("Template %s disimpan, pakai dengan --format %s", name, name))
 	}
 
 	// Save configuration
 	err = config.SaveConfig(cfg)
 	if err != nil {
-		fmt.Printf("Error menyimpan konfigurasi: %v\n", err)
-		return
+		return i18n.Errorf("gagal menyimpan konfigurasi: %v", err)
 	}
 
-	fmt.Println("Konfigurasi berhasil disimpan!")
+	fmt.Println(i18n.T("Konfigurasi berhasil disimpan!"))
+	warnInvalidConfig(cfg)
+	return nil
+}
+
+// warnInvalidConfig lists the invalid values left in the configuration
+func warnInvalidConfig(cfg *config.Config) {
+	var valErr *config.ValidationError
+	if err := cfg.Validate(); errors.As(err, &valErr) {
+		fmt.Println()
+		fmt.Println(i18n.T("⚠️  Nilai konfigurasi berikut masih tidak valid:"))
+		for _, f := range valErr.Fields {
+			fmt.Printf("   - %v\n", f)
+		}
+	}
 }
@@ -0,0 +1,463 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"jadwalsalat/config"
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// configUnsetCmd represents the config unset command
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset [key...]",
+	Short: "Kembalikan nilai konfigurasi ke default",
+	Long: `Kembalikan satu atau beberapa nilai konfigurasi ke default-nya. Kunci lokasi
+(adjustments, method) berlaku untuk profil yang sedang dipakai.
+
+Gunakan --all untuk mereset seluruh konfigurasi: file lama disimpan sebagai
+cadangan lalu dihapus, dan lokasi perlu diatur ulang dengan 'salat setup'.
+
+Contoh penggunaan:
+  salat config unset geocoding_url
+  salat config unset adjustments.subuh adjustments.isya
+  salat config unset adjustments
+  salat config unset smtp
+  salat config unset display
+  salat config unset themes.senja
+  salat config unset formats.tmux
+  salat config unset --all`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		all, _ := cmd.Flags().GetBool("all")
+		if all != (len(args) == 0) {
+			return &usageError{i18n.Errorf("berikan kunci konfigurasi atau --all")}
+		}
+		if all {
+			return resetConfig()
+		}
+		return unsetConfig(args)
+	},
+}
+
+// configEditCmd represents the config edit command
+var configEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Ubah file konfigurasi dengan editor",
+	Long: `Buka file konfigurasi di editor dari $VISUAL atau $EDITOR (default vi).
+
+Perubahan baru disimpan setelah semua nilai valid. Jika ada nilai yang tidak
+valid, daftar kesalahannya ditampilkan dan editor bisa dibuka lagi.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return editConfig()
+	},
+}
+
+// configPathCmd represents the config path command
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Tampilkan lokasi file konfigurasi",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, err := config.ConfigPath()
+		if err != nil {
+			return err
+		}
+		fmt.Println(path)
+		return nil
+	},
+}
+
+// configExportCmd represents the config export command
+var configExportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Ekspor konfigurasi ke file YAML atau JSON",
+	Long: `Ekspor seluruh konfigurasi, termasuk semua profil, ke file atau stdout.
+
+Format mengikuti ekstensi file (.json atau .yaml), atau --format. Password
+SMTP tidak ikut diekspor kecuali dengan --secrets.
+
+Contoh penggunaan:
+  salat config export salat.yaml
+  salat config export --format json > salat.json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("format")
+		secrets, _ := cmd.Flags().GetBool("secrets")
+		file := ""
+		if len(args) == 1 {
+			file = args[0]
+		}
+		return exportConfig(file, format, secrets)
+	},
+}
+
+// configImportCmd represents the config import command
+var configImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Impor konfigurasi dari file YAML atau JSON",
+	Long: `Impor konfigurasi hasil 'salat config export'. Gunakan "-" untuk membaca stdin.
+
+Secara default nilai dari file digabung dengan konfigurasi yang ada, sehingga
+nilai yang tidak diekspor (misalnya password SMTP) tetap tersimpan. Dengan
+--replace seluruh konfigurasi diganti. Konfigurasi lama selalu disimpan
+sebagai cadangan.
+
+Contoh penggunaan:
+  salat config import salat.yaml
+  salat config import --replace salat.json
+  cat salat.yaml | salat config import -`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("format")
+		replace, _ := cmd.Flags().GetBool("replace")
+		return importConfig(args[0], format, replace)
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configUnsetCmd)
+	configCmd.AddCommand(configEditCmd)
+	configCmd.AddCommand(configPathCmd)
+	configCmd.AddCommand(configExportCmd)
+	configCmd.AddCommand(configImportCmd)
+	configUnsetCmd.Flags().Bool("all", false, "Reset seluruh konfigurasi")
+	configExportCmd.Flags().String("format", "", "Format file: yaml atau json (default dari ekstensi file)")
+	configExportCmd.Flags().Bool("secrets", false, "Ikutkan password SMTP")
+	configImportCmd.Flags().String("format", "", "Format file: yaml atau json (default dari ekstensi file)")
+	configImportCmd.Flags().Bool("replace", false, "Ganti seluruh konfigurasi, bukan digabung")
+}
+
+// unsetConfig restores the given keys to their defaults
+func unsetConfig(keys []string) error {
+	cfg, err := config.LoadConfigUnvalidated()
+	if err != nil {
+		return loadError(err)
+	}
+
+	for _, key := range keys {
+		switch key = strings.ToLower(key); key {
+		case "method":
+			cfg.Method = string(salat.Auto)
+		case "adjustments":
+			cfg.Adjustments = salat.Adjustments{}
+		case "adjustments.imsak", "adjustments.subuh", "adjustments.dzuhur", "adjustments.ashar", "adjustments.maghrib", "adjustments.isya":
+			cfg.Adjustments.Set(strings.TrimPrefix(key, "adjustments."), 0)
+		case "active_profile":
+			cfg.ActiveProfile = ""
+		case "language":
+			cfg.Language = ""
+		case "geocoding_api":
+			cfg.GeocodingAPI = ""
+		case "geocoding_url":
+			cfg.GeocodingURL = ""
+		case "geocoding_email":
+			cfg.GeocodingEmail = ""
+		case "geocoding_cache_ttl":
+			cfg.GeocodingCacheTTL = ""
+		case "smtp":
+			cfg.SMTP = config.SMTPConfig{}
+		case "smtp.host":
+			cfg.SMTP.Host = ""
+		case "smtp.port":
+			cfg.SMTP.Port = 0
+		case "smtp.username":
+			cfg.SMTP.Username = ""
+		case "smtp.password":
+			cfg.SMTP.Password = ""
+		case "smtp.from":
+			cfg.SMTP.From = ""
+		case "digest.recipients":
+			cfg.Digest.Recipients = nil
+		case "display":
+			cfg.Display = config.DisplayConfig{}
+		case "display.no_color":
+			cfg.Display.NoColor = false
+		case "display.no_emoji":
+			cfg.Display.NoEmoji = false
+		case "display.screen_reader":
+			cfg.Display.ScreenReader = false
+		case "display.theme":
+			cfg.Display.Theme = ""
+		case "display.clock":
+			cfg.Display.Clock = ""
+		case "display.seconds":
+			cfg.Display.Seconds = false
+		case "display.pasaran":
+			cfg.Display.Pasaran = false
+		case "themes":
+			cfg.Themes = nil
+		case "formats":
+			cfg.Formats = nil
+		case "timezone", "location", "lokasi", "latitude", "longitude":
+			return &usageError{i18n.Errorf("lokasi tidak bisa dikosongkan, ubah dengan 'salat config set location' atau 'salat setup'")}
+		default:
+			if rest, ok := strings.CutPrefix(key, "themes."); ok {
+				if err := unsetThemeStyle(cfg, rest); err != nil {
+					return err
+				}
+				break
+			}
+			name, ok := strings.CutPrefix(key, "formats.")
+			if _, exists := cfg.Formats[name]; !ok || !exists {
+				return &usageError{i18n.Errorf("kunci konfigurasi tidak valid: %s. Pilih salah satu dari: method, adjustments, adjustments.<waktu>, active_profile, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, smtp, smtp.<kunci>, digest.recipients, display, display.<kunci>, themes, themes.<nama>, themes.<nama>.<bagian>, formats, formats.<nama>", key)}
+			}
+			delete(cfg.Formats, name)
+		}
+		fmt.Println(i18n.Sprintf("%s dikembalikan ke default", key))
+	}
+
+	if err := config.SaveConfig(cfg); err != nil {
+		return i18n.Errorf("gagal menyimpan konfigurasi: %v", err)
+	}
+	fmt.Println(i18n.T("Konfigurasi berhasil disimpan!"))
+	warnInvalidConfig(cfg)
+	return nil
+}
+
+// resetConfig backs up the config file and removes it
+func resetConfig() error {
+	path, err := config.ConfigPath()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		fmt.Println(i18n.T("Belum ada file konfigurasi, tidak ada yang direset."))
+		return nil
+	}
+
+	backup, err := config.BackupConfig(path)
+	if err != nil {
+		return i18n.Errorf("gagal membuat cadangan konfigurasi: %v", err)
+	}
+	if err := os.Remove(path); err != nil {
+		return i18n.Errorf("gagal menghapus konfigurasi: %v", err)
+	}
+
+	fmt.Println(i18n.Sprintf("Konfigurasi direset. Cadangan: %s", backup))
+	fmt.Println(i18n.T("Jalankan 'salat setup' untuk mengatur lokasi."))
+	return nil
+}
+
+// editConfig opens the config file in an editor and saves the result once valid
+func editConfig() error {
+	path, err := config.ConfigPath()
+	if err != nil {
+		return err
+	}
+	original, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &configError{i18n.Errorf("belum ada file konfigurasi di %s", path)}
+	}
+	if err != nil {
+		return i18n.Errorf("gagal membaca konfigurasi: %v", err)
+	}
+
+	// Edit a copy so an invalid file never replaces the working one
+	tmp, err := os.CreateTemp("", "salat-*"+filepath.Ext(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(original); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	for {
+		if err := runEditor(tmp.Name()); err != nil {
+			return err
+		}
+		edited, err := os.ReadFile(tmp.Name())
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(edited, original) {
+			fmt.Println(i18n.T("Tidak ada perubahan."))
+			return nil
+		}
+
+		settings, err := config.ReadSettings(tmp.Name())
+		if err == nil {
+			err = config.ValidateSettings(settings)
+		}
+		if err == nil {
+			info, statErr := os.Stat(path)
+			mode := os.FileMode(0600)
+			if statErr == nil {
+				mode = info.Mode().Perm()
+			}
+			if err := os.WriteFile(path, edited, mode); err != nil {
+				return i18n.Errorf("gagal menyimpan konfigurasi: %v", err)
+			}
+			fmt.Println(i18n.T("Konfigurasi berhasil disimpan!"))
+			return nil
+		}
+
+		if !canPrompt() {
+			return err
+		}
+		fmt.Fprintln(os.Stderr, i18n.Sprintf("Konfigurasi tidak valid: %v", err))
+		again := true
+		prompt := &survey.Confirm{Message: i18n.T("Edit lagi? (Tidak = batalkan perubahan)"), Default: true}
+		if err := survey.AskOne(prompt, &again); err != nil || !again {
+			fmt.Println(i18n.T("Perubahan dibatalkan, konfigurasi tidak diubah."))
+			return nil
+		}
+	}
+}
+
+// runEditor opens path in the editor from $VISUAL or $EDITOR
+func runEditor(path string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+		if runtime.GOOS == "windows" {
+			editor = "notepad"
+		}
+	}
+
+	// The editor may come with arguments, e.g. "code --wait"
+	args := strings.Fields(editor)
+	cmd := exec.Command(args[0], append(args[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return i18n.Errorf("gagal menjalankan editor %s: %v", editor, err)
+	}
+	return nil
+}
+
+// exportConfig writes the config file's settings to file, or stdout when empty
+func exportConfig(file, format string, secrets bool) error {
+	path, err := config.ConfigPath()
+	if err != nil {
+		return err
+	}
+	settings, err := config.ReadSettings(path)
+	if err != nil {
+		if config.IsNotExist(err) {
+			return &configError{i18n.Errorf("belum ada file konfigurasi di %s", path)}
+		}
+		return &configError{i18n.Errorf("gagal membaca konfigurasi: %v", err)}
+	}
+
+	format, err = bundleFormat(format, file)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if file != "" {
+		f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+		if err != nil {
+			return i18n.Errorf("gagal membuat file ekspor: %v", err)
+		}
+		defer f.Close()
+		out = f
+	}
+	if err := config.EncodeSettings(out, settings, format, secrets); err != nil {
+		return i18n.Errorf("gagal mengekspor konfigurasi: %v", err)
+	}
+	if file != "" {
+		fmt.Fprintln(os.Stderr, i18n.Sprintf("Konfigurasi diekspor ke %s", file))
+	}
+	return nil
+}
+
+// importConfig merges or replaces the configuration with a bundle
+func importConfig(file, format string, replace bool) error {
+	if file == "-" {
+		file = ""
+	}
+	format, err := bundleFormat(format, file)
+	if err != nil {
+		return err
+	}
+
+	var in io.Reader = os.Stdin
+	if file != "" {
+		f, err := os.Open(file)
+		if err != nil {
+			return i18n.Errorf("gagal membuka file impor: %v", err)
+		}
+		defer f.Close()
+		in = f
+	}
+	bundle, err := config.DecodeSettings(in, format)
+	if err != nil {
+		return &usageError{i18n.Errorf("file impor tidak valid: %v", err)}
+	}
+
+	path, err := config.ConfigPath()
+	if err != nil {
+		return err
+	}
+	current, err := config.ReadSettings(path)
+	exists := !config.IsNotExist(err)
+	if err != nil && exists && !replace {
+		// Merging would drop the unreadable file's settings
+		return &configError{i18n.Errorf("gagal membaca konfigurasi: %v (gunakan --replace untuk menggantinya, cadangan tetap dibuat)", err)}
+	}
+	if err != nil || replace {
+		current = map[string]interface{}{}
+	}
+
+	// Values of the bundle win; with --replace nothing else is kept
+	merged := viper.New()
+	if err := merged.MergeConfigMap(current); err != nil {
+		return err
+	}
+	if err := merged.MergeConfigMap(bundle); err != nil {
+		return err
+	}
+	settings := merged.AllSettings()
+	if err := config.ValidateSettings(settings); err != nil {
+		return err
+	}
+
+	if exists {
+		backup, err := config.BackupConfig(path)
+		if err != nil {
+			return i18n.Errorf("gagal membuat cadangan konfigurasi: %v", err)
+		}
+		fmt.Println(i18n.Sprintf("Cadangan konfigurasi lama: %s", backup))
+	}
+	if err := config.WriteSettings(path, settings); err != nil {
+		return i18n.Errorf("gagal menyimpan konfigurasi: %v", err)
+	}
+	fmt.Println(i18n.Sprintf("Konfigurasi diimpor ke %s", path))
+	return nil
+}
+
+// bundleFormat returns the export/import format from --format or the file extension
+func bundleFormat(format, file string) (string, error) {
+	switch strings.ToLower(format) {
+	case "":
+		return config.FormatFromPath(file), nil
+	case "yaml", "yml":
+		return "yaml", nil
+	case "json":
+		return "json", nil
+	default:
+		return "", &usageError{i18n.Errorf("format tidak valid: %s (pilih yaml atau json)", format)}
+	}
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"jadwalsalat/config"
+
+	"github.com/spf13/viper"
+)
+
+const testConfig = `version: 1
+active_profile: default
+language: en
+smtp:
+  host: smtp.example.com
+  password: rahasia
+formats:
+  tmux: "{{.Next.Name}}"
+profiles:
+  default:
+    timezone: Asia/Jakarta
+    latitude: -6.2
+    longitude: 106.8
+    method: MWL
+    adjustments:
+      subuh: 2
+`
+
+// useConfig makes a config file with content the one in use, as the root
+// command does with --config, and returns its path. The config directory
+// is moved to a temporary home.
+func useConfig(t *testing.T, content string) string {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if content != "" {
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.SetConfigFile(path)
+	config.ReadInConfig()
+	nonInteractive = true
+	t.Cleanup(func() { nonInteractive = false })
+	return path
+}
+
+// readConfig returns the settings of the config file at path
+func readConfig(t *testing.T, path string) *viper.Viper {
+	t.Helper()
+	settings, err := config.ReadSettings(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := viper.New()
+	v.MergeConfigMap(settings)
+	return v
+}
+
+func TestUnsetConfig(t *testing.T) {
+	path := useConfig(t, testConfig)
+	if err := unsetConfig([]string{"language", "adjustments.subuh", "formats.tmux", "smtp.host"}); err != nil {
+		t.Fatal(err)
+	}
+
+	v := readConfig(t, path)
+	for _, key := range []string{"language", "smtp.host"} {
+		if got := v.GetString(key); got != "" {
+			t.Errorf("%s = %q, want it unset", key, got)
+		}
+	}
+	if got := v.GetInt("profiles.default.adjustments.subuh"); got != 0 {
+		t.Errorf("adjustments.subuh = %d, want 0", got)
+	}
+	if v.IsSet("formats.tmux") {
+		t.Error("formats.tmux is still set")
+	}
+	if got := v.GetString("smtp.password"); got != "rahasia" {
+		t.Errorf("smtp.password = %q, want it kept", got)
+	}
+	if got := v.GetString("profiles.default.method"); got != "MWL" {
+		t.Errorf("method = %q, want it kept", got)
+	}
+
+	var usage *usageError
+	for _, key := range []string{"latitude", "formats.tidakada", "warna"} {
+		if err := unsetConfig([]string{key}); !errors.As(err, &usage) {
+			t.Errorf("unset %s = %v, want a usage error", key, err)
+		}
+	}
+}
+
+func TestEditConfig(t *testing.T) {
+	path := useConfig(t, testConfig)
+	t.Setenv("VISUAL", "")
+
+	t.Setenv("EDITOR", "sed -i s/MWL/Kemenag/")
+	if err := editConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if got := readConfig(t, path).GetString("profiles.default.method"); got != "Kemenag" {
+		t.Errorf("method = %q, want the edit saved", got)
+	}
+
+	// An invalid edit never replaces the file
+	t.Setenv("EDITOR", "sed -i s/Asia.Jakarta/Asia\\/Atlantis/")
+	var valErr *config.ValidationError
+	if err := editConfig(); !errors.As(err, &valErr) {
+		t.Fatalf("editConfig = %v, want a validation error", err)
+	}
+	if got := readConfig(t, path).GetString("profiles.default.timezone"); got != "Asia/Jakarta" {
+		t.Errorf("timezone = %q, want the file left as it was", got)
+	}
+}
+
+func TestExportImportConfig(t *testing.T) {
+	path := useConfig(t, testConfig)
+	bundle := filepath.Join(t.TempDir(), "salat.json")
+	if err := exportConfig(bundle, "", false); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(data), "{") {
+		t.Errorf("export to .json is not JSON:\n%s", data)
+	}
+	if strings.Contains(string(data), "rahasia") {
+		t.Errorf("export without --secrets has the SMTP password:\n%s", data)
+	}
+
+	// Merging keeps the password, which the bundle does not have
+	if err := os.WriteFile(path, []byte(strings.Replace(testConfig, "language: en", "language: ar", 1)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := importConfig(bundle, "", false); err != nil {
+		t.Fatal(err)
+	}
+	v := readConfig(t, path)
+	if got := v.GetString("language"); got != "en" {
+		t.Errorf("language = %q, want en from the bundle", got)
+	}
+	if got := v.GetString("smtp.password"); got != "rahasia" {
+		t.Errorf("smtp.password = %q, want it kept", got)
+	}
+	backups, _ := filepath.Glob(path + ".*.bak")
+	if len(backups) != 1 {
+		t.Errorf("backups = %v, want one", backups)
+	}
+
+	// Replacing drops it
+	if err := importConfig(bundle, "", true); err != nil {
+		t.Fatal(err)
+	}
+	if v := readConfig(t, path); v.IsSet("smtp.password") {
+		t.Errorf("smtp.password = %q, want it gone with --replace", v.GetString("smtp.password"))
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0600 {
+		t.Errorf("mode = %v, want 0600", mode)
+	}
+}
+
+func TestImportUnreadableConfig(t *testing.T) {
+	path := useConfig(t, testConfig)
+	bundle := filepath.Join(t.TempDir(), "salat.yaml")
+	if err := exportConfig(bundle, "", true); err != nil {
+		t.Fatal(err)
+	}
+	corrupt := []byte("profiles: [\n")
+	if err := os.WriteFile(path, corrupt, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var cfgErr *configError
+	if err := importConfig(bundle, "", false); !errors.As(err, &cfgErr) {
+		t.Fatalf("importConfig = %v, want a config error", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != string(corrupt) {
+		t.Errorf("config file was changed to:\n%s", data)
+	}
+
+	if err := importConfig(bundle, "", true); err != nil {
+		t.Fatal(err)
+	}
+	backups, _ := filepath.Glob(path + ".*.bak")
+	if len(backups) != 1 {
+		t.Fatalf("backups = %v, want one", backups)
+	}
+	if data, _ := os.ReadFile(backups[0]); string(data) != string(corrupt) {
+		t.Errorf("backup = %q, want the unreadable file", data)
+	}
+	if got := readConfig(t, path).GetString("profiles.default.method"); got != "MWL" {
+		t.Errorf("method = %q, want MWL from the bundle", got)
+	}
+}
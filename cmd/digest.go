@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"jadwalsalat/digest"
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+
+	"github.com/spf13/cobra"
+)
+
+// digestCmd represents the digest command
+var digestCmd = &cobra.Command{
+	Use:   "digest",
+	Short: "Kirim jadwal sholat lewat email",
+	Long: `Kirim jadwal sholat hari ini (atau seminggu) lewat email dalam format teks
+dan HTML, lengkap dengan lampiran .ics untuk kalender.
+
+Atur server SMTP dan penerima terlebih dahulu:
+  salat config set smtp.host smtp.gmail.com
+  salat config set smtp.port 587
+  salat config set smtp.username admin@example.org
+  salat config set smtp.from "Masjid Al-Ikhlas <admin@example.org>"
+  salat config set digest.recipients "jamaah1@example.org,jamaah2@example.org"
+
+Berikan password SMTP lewat environment variable SALAT_SMTP_PASSWORD, misalnya
+dari secret manager atau file crontab yang hanya bisa dibaca Anda. Password
+dari environment tidak pernah ditulis ke config.yaml. 'salat config set
+smtp.password' juga bisa dipakai, tetapi password tersimpan sebagai teks biasa.
+
+Contoh penggunaan:
+  salat digest
+  salat digest --week
+  salat digest --to pengurus@example.org
+  salat digest --week --dry-run > digest.eml`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		week, _ := cmd.Flags().GetBool("week")
+		to, _ := cmd.Flags().GetStringSlice("to")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		return sendDigest(week, to, dryRun)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(digestCmd)
+	addOverrideFlags(digestCmd)
+	digestCmd.Flags().BoolP("week", "w", false, "Kirim jadwal 7 hari ke depan")
+	digestCmd.Flags().StringSlice("to", nil, "Penerima email (menggantikan digest.recipients)")
+	digestCmd.Flags().Bool("dry-run", false, "Tulis email ke stdout tanpa mengirim")
+}
+
+// sendDigest builds the schedule email and sends it via SMTP
+func sendDigest(week bool, to []string, dryRun bool) error {
+	// Load configuration
+	cfg, loc, err := loadLocationConfig()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().In(loc)
+
+	location := cfg.SalatLocation()
+
+	days := 1
+	if week {
+		days = 7
+	}
+
+	schedule := &digest.Schedule{
+		LocationName: getLocationNameFromConfig(cfg),
+		Latitude:     cfg.Latitude,
+		Longitude:    cfg.Longitude,
+		Method:       methodLabel(cfg),
+		Lang:         i18n.Current(),
+	}
+	for i := 0; i < days; i++ {
+		date := now.AddDate(0, 0, i)
+		times, err := salat.TimesForDate(date, location)
+		if err != nil {
+			return i18n.Errorf("gagal menghitung jadwal sholat: %w", err)
+		}
+		schedule.Days = append(schedule.Days, digest.Day{Date: date, Times: times})
+	}
+
+	if len(to) == 0 {
+		to = cfg.Digest.Recipients
+	}
+
+	msg, err := digest.Compose(cfg.SMTP.From, to, schedule, now)
+	if err != nil {
+		return i18n.Errorf("gagal menyusun email: %v", err)
+	}
+
+	if dryRun {
+		_, err := os.Stdout.Write(msg)
+		return err
+	}
+
+	smtpCfg := cfg.SMTP
+	if smtpCfg.Password == "" {
+		smtpCfg.Password = os.Getenv("SALAT_SMTP_PASSWORD")
+	}
+
+	fmt.Println(i18n.Sprintf("📧 Mengirim digest ke %d penerima...", len(to)))
+	if err := digest.Send(smtpCfg, to, msg); err != nil {
+		return i18n.Errorf("gagal mengirim email: %v", err)
+	}
+
+	fmt.Println(i18n.T("✅ Digest berhasil dikirim!"))
+	return nil
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"os"
+
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/fatih/color"
+	"github.com/muesli/termenv"
+	"github.com/spf13/viper"
+)
+
+// Display flags chosen for this run, see applyDisplay
+var (
+	noColorFlag      bool
+	noEmojiFlag      bool
+	plainFlag        bool
+	screenReaderFlag bool
+	clockFlag        string
+	secondsFlag      bool
+	pasaranFlag      bool
+)
+
+// displayPrefs are the accessibility preferences in effect
+type displayPrefs struct {
+	noColor      bool
+	noEmoji      bool
+	screenReader bool
+}
+
+// display holds the preferences of this run, set by applyDisplay
+var display displayPrefs
+
+// addDisplayFlags adds the accessibility, theme, and date flags to the root
+// command
+func addDisplayFlags() {
+	flags := rootCmd.PersistentFlags()
+	flags.BoolVar(&noColorFlag, "no-color", false, "Tanpa warna (juga dengan NO_COLOR)")
+	flags.BoolVar(&noEmojiFlag, "no-emoji", false, "Tanpa emoji")
+	flags.BoolVar(&plainFlag, "plain", false, "Tanpa warna dan emoji")
+	flags.BoolVar(&screenReaderFlag, "screen-reader", false, "Kalimat lengkap untuk pembaca layar, tanpa tabel dan progress bar")
+	flags.StringVarP(&themeFlag, "theme", "t", "", "Tema warna (dark, light, high-contrast, mono, atau tema dari konfigurasi)")
+	flags.StringVar(&clockFlag, "clock", "", "Format jam: 12h atau 24h (default 24h)")
+	flags.BoolVar(&secondsFlag, "seconds", false, "Tampilkan detik pada waktu sholat")
+	flags.BoolVar(&pasaranFlag, "pasaran", false, "Tambahkan pasaran Jawa pada tanggal, misalnya Senin Wage")
+}
+
+// applyDisplay sets the preferences in effect from the flags, NO_COLOR, and
+// the display section of the configuration (or SALAT_DISPLAY_*). Screen
+// reader mode implies plain output, since emoji are read aloud by name.
+// Date and clock preferences go to package i18n.
+func applyDisplay() {
+	display.screenReader = screenReaderFlag || viper.GetBool("display.screen_reader")
+	plain := plainFlag || display.screenReader
+	display.noColor = plain || noColorFlag || os.Getenv("NO_COLOR") != "" || viper.GetBool("display.no_color")
+	display.noEmoji = plain || noEmojiFlag || viper.GetBool("display.no_emoji")
+
+	if display.noColor {
+		color.NoColor = true
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+	i18n.SetEmoji(!display.noEmoji)
+
+	clock := clockFlag
+	if clock == "" {
+		clock = viper.GetString("display.clock")
+	}
+	i18n.SetClockFormat(i18n.ClockFormat{
+		Hour12:  clock == "12h",
+		Seconds: secondsFlag || viper.GetBool("display.seconds"),
+	})
+	i18n.SetPasaran(pasaranFlag || viper.GetBool("display.pasaran"))
+}
+
+// icon returns emoji followed by a space, or nothing when emoji are off
+func icon(emoji string) string {
+	if display.noEmoji {
+		return ""
+	}
+	return emoji + " "
+}
+
+// prayerIcon returns the emoji of a prayer followed by a space, or nothing
+// when emoji are off
+func prayerIcon(p salat.Prayer) string {
+	if display.noEmoji {
+		return ""
+	}
+	return p.Emoji()
+}
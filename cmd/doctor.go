@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"jadwalsalat/config"
+	"jadwalsalat/geocode"
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// pingTimeout bounds the geocoder reachability check
+const pingTimeout = 5 * time.Second
+
+// maxNoonDeviation is how far Dzuhur may be from mean solar noon; the
+// equation of time stays within about 16.5 minutes
+const maxNoonDeviation = 17 * time.Minute
+
+// doctorCmd represents the doctor command
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Periksa konfigurasi dan lingkungan aplikasi",
+	Long: `Periksa apakah salat siap dipakai:
+
+  - konfigurasi: file ada dan semua nilai (termasuk setiap profil) valid
+  - timezone: database timezone tersedia dan timezone sesuai dengan lokasi
+  - geocoding: gazetteer offline terbaca dan provider online dapat dihubungi
+  - perhitungan: jadwal hari ini terdefinisi, berurutan, dan Dzuhur dekat
+    tengah hari matahari
+
+Keluar dengan kode 1 jika ada pemeriksaan yang gagal.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		offline, _ := cmd.Flags().GetBool("offline")
+		return runDoctor(offline)
+	},
+}
+
+// configDoctorCmd makes the doctor available as "salat config doctor"
+var configDoctorCmd = &cobra.Command{
+	Use:   doctorCmd.Use,
+	Short: doctorCmd.Short,
+	Long:  doctorCmd.Long,
+	RunE:  doctorCmd.RunE,
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+	configCmd.AddCommand(configDoctorCmd)
+	for _, c := range []*cobra.Command{doctorCmd, configDoctorCmd} {
+		c.Flags().Bool("offline", false, "Lewati pemeriksaan koneksi ke provider geocoding")
+	}
+}
+
+// doctor prints check results and counts the failures
+type doctor struct {
+	failed int
+	warned int
+}
+
+func (d *doctor) section(title string) {
+	color.New(color.FgHiCyan, color.Bold).Printf("\n%s\n", title)
+}
+
+func (d *doctor) ok(msg string) {
+	fmt.Printf("  %s %s\n", doctorMark("✅", "✓", i18n.T("OK:")), msg)
+}
+
+func (d *doctor) warn(msg string) {
+	d.warned++
+	color.New(color.FgYellow).Printf("  %s %s\n", doctorMark("⚠️ ", "!", i18n.T("Peringatan:")), msg)
+}
+
+func (d *doctor) fail(msg string) {
+	d.failed++
+	color.New(color.FgRed).Printf("  %s %s\n", doctorMark("❌", "✗", i18n.T("Gagal:")), msg)
+}
+
+// doctorMark returns the mark of a check result: the emoji, a plain symbol
+// without emoji, or a word for screen readers
+func doctorMark(emoji, symbol, word string) string {
+	switch {
+	case display.screenReader:
+		return word
+	case display.noEmoji:
+		return symbol
+	default:
+		return emoji
+	}
+}
+
+// runDoctor checks the configuration, timezone data, geocoders, and today's calculation
+func runDoctor(offline bool) error {
+	d := &doctor{}
+	color.New(color.FgHiCyan, color.Bold).Println(i18n.T("🩺 Pemeriksaan salat"))
+
+	cfg := checkConfig(d)
+	loc := checkTimezone(d, cfg)
+	checkGeocoding(d, cfg, offline)
+	checkCalculation(d, cfg, loc)
+
+	fmt.Println()
+	switch {
+	case d.failed > 0:
+		return i18n.Errorf("%d pemeriksaan gagal, %d peringatan", d.failed, d.warned)
+	case d.warned > 0:
+		fmt.Println(i18n.Sprintf("Selesai dengan %d peringatan.", d.warned))
+	default:
+		fmt.Println(i18n.T("Semua pemeriksaan berhasil."))
+	}
+	return nil
+}
+
+// checkConfig validates the config file and every profile. It returns the
+// configuration with the selected profile in effect, or nil when unusable.
+func checkConfig(d *doctor) *config.Config {
+	d.section(i18n.T("Konfigurasi"))
+
+	// Parse the file itself; without it the checks below see an empty configuration
+	if path, err := config.ConfigPath(); err != nil {
+		d.fail(i18n.Sprintf("lokasi file konfigurasi tidak diketahui: %v", err))
+	} else if _, err := config.ReadSettings(path); config.IsNotExist(err) {
+		d.fail(i18n.Sprintf("file konfigurasi %s belum ada (jalankan 'salat setup')", path))
+	} else if err != nil {
+		d.fail(i18n.Sprintf("file konfigurasi %s tidak bisa dibaca: %v", path, err))
+		return nil
+	} else {
+		d.ok(i18n.Sprintf("file konfigurasi: %s", path))
+	}
+
+	base, err := config.LoadBaseConfig()
+	if err != nil {
+		d.fail(i18n.Sprintf("konfigurasi tidak bisa dibaca: %v", err))
+		return nil
+	}
+
+	// Check the global settings and every profile, not only the one in effect
+	var valErr *config.ValidationError
+	if err := base.ValidateAll(); errors.As(err, &valErr) {
+		for _, f := range valErr.Fields {
+			d.fail(f.Error())
+		}
+	} else {
+		d.ok(i18n.Sprintf("semua nilai konfigurasi valid (versi %d)", base.Version))
+	}
+
+	cfg := *base
+	if err := cfg.UseProfile(cfg.SelectedProfile()); err != nil {
+		d.fail(err.Error())
+		return nil
+	}
+	if !cfg.HasLocation() {
+		d.fail(i18n.T("lokasi belum diatur (jalankan 'salat setup')"))
+		return nil
+	}
+	if cfg.Profile != "" {
+		d.ok(i18n.Sprintf("profil: %s", cfg.Profile))
+	}
+	d.ok(i18n.Sprintf("lokasi: %s (%.6f, %.6f)", getLocationNameFromConfig(&cfg), cfg.Latitude, cfg.Longitude))
+	return &cfg
+}
+
+// checkTimezone checks the timezone database and the configured timezone
+func checkTimezone(d *doctor, cfg *config.Config) *time.Location {
+	d.section(i18n.T("Timezone"))
+
+	if _, err := time.LoadLocation("Asia/Jayapura"); err != nil {
+		d.fail(i18n.Sprintf("database timezone tidak tersedia: %v", err))
+		return nil
+	}
+	d.ok(i18n.T("database timezone tersedia"))
+
+	if cfg == nil {
+		return nil
+	}
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		d.fail(i18n.Sprintf("timezone %q tidak dikenal", cfg.Timezone))
+		return nil
+	}
+	d.ok(i18n.Sprintf("timezone: %s (%s)", cfg.Timezone, utcOffset(time.Now().In(loc))))
+
+	want, err := geocode.Timezone(cfg.Latitude, cfg.Longitude)
+	switch {
+	case err != nil:
+		d.warn(i18n.Sprintf("timezone lokasi tidak dapat ditentukan: %v", err))
+	case !timezoneMatches(cfg.Timezone, want):
+		d.warn(i18n.Sprintf("timezone %s tidak sesuai dengan lokasi (%s), jalankan 'salat config set timezone %s'", cfg.Timezone, want, want))
+	default:
+		d.ok(i18n.T("timezone sesuai dengan lokasi"))
+	}
+	return loc
+}
+
+// checkGeocoding checks the offline gazetteer and, unless offline, whether
+// the online provider is reachable
+func checkGeocoding(d *doctor, cfg *config.Config, offline bool) {
+	d.section(i18n.T("Geocoding"))
+
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		d.fail(i18n.Sprintf("direktori konfigurasi tidak ditemukan: %v", err))
+		return
+	}
+	if g, err := geocode.LoadGazetteer(geocode.GazetteerDir(configDir)); err != nil {
+		d.fail(i18n.Sprintf("gazetteer offline tidak bisa dibaca: %v", err))
+	} else {
+		d.ok(i18n.Sprintf("gazetteer offline: %d lokasi", g.Len()))
+	}
+
+	apiType := viper.GetString("geocoding_api")
+	if apiType == "" {
+		apiType = "nominatim"
+	}
+	switch {
+	case apiType == config.OfflineGeocoder:
+		d.ok(i18n.T("provider: offline, tidak memerlukan internet"))
+		return
+	case offline:
+		d.ok(i18n.Sprintf("provider %s tidak diperiksa (--offline)", apiType))
+		return
+	}
+
+	lat, lon := -6.2, 106.8
+	if cfg != nil {
+		lat, lon = cfg.Latitude, cfg.Longitude
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+	elapsed, err := config.PingGeocoder(ctx, apiType, lat, lon)
+	var notFound *geocode.NotFoundError
+	if err != nil && !errors.As(err, &notFound) {
+		d.warn(i18n.Sprintf("provider %s tidak dapat dihubungi: %v (geocoding memakai gazetteer offline)", apiType, err))
+		return
+	}
+	d.ok(i18n.Sprintf("provider %s dapat dihubungi (%d ms)", apiType, elapsed.Milliseconds()))
+}
+
+// checkCalculation checks that today's prayer times exist, are in order, and
+// that Dzuhur is close to mean solar noon
+func checkCalculation(d *doctor, cfg *config.Config, loc *time.Location) {
+	d.section(i18n.T("Perhitungan"))
+	if cfg == nil || loc == nil {
+		fmt.Println(i18n.T("  ⏭️  dilewati karena konfigurasi belum valid"))
+		return
+	}
+
+	now := time.Now().In(loc)
+	location := cfg.SalatLocation()
+	d.ok(i18n.Sprintf("metode: %s", methodLabel(cfg)))
+
+	times, err := salat.TimesForDate(now, location)
+	if err != nil {
+		d.fail(err.Error())
+		return
+	}
+
+	order := times.All()
+	inOrder := true
+	for i := 1; i < len(order); i++ {
+		prev, cur := order[i-1], order[i]
+		if !prev.Time.Before(cur.Time) {
+			d.fail(i18n.Sprintf("%s (%s) tidak sebelum %s (%s)", prev.Prayer.Name(i18n.Current()), prev.Time.Format("15:04"), cur.Prayer.Name(i18n.Current()), cur.Time.Format("15:04")))
+			inOrder = false
+		}
+	}
+	if inOrder {
+		d.ok(i18n.Sprintf("jadwal hari ini berurutan: Subuh %s, Dzuhur %s, Maghrib %s", times.Subuh.Format("15:04"), times.Dzuhur.Format("15:04"), times.Maghrib.Format("15:04")))
+	}
+
+	// Mean solar noon from the longitude alone, before the equation of time
+	_, offset := now.Zone()
+	hours := 12 + float64(offset)/3600 - cfg.Longitude/15
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	noon := midnight.Add(time.Duration(hours * float64(time.Hour)))
+	dzuhur := times.Dzuhur.Add(-time.Duration(cfg.Adjustments.Dzuhur) * time.Minute)
+	if deviation := dzuhur.Sub(noon); math.Abs(deviation.Minutes()) > maxNoonDeviation.Minutes() {
+		d.fail(i18n.Sprintf("Dzuhur %s menyimpang %.0f menit dari tengah hari matahari (%s)", dzuhur.Format("15:04"), deviation.Minutes(), noon.Format("15:04")))
+	} else {
+		d.ok(i18n.Sprintf("Dzuhur dalam %.0f menit dari tengah hari matahari", math.Abs(deviation.Minutes())))
+	}
+}
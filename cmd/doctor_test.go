@@ -0,0 +1,24 @@
+package cmd
+
+import "testing"
+
+func TestCheckConfig(t *testing.T) {
+	useConfig(t, testConfig)
+	d := &doctor{}
+	if cfg := checkConfig(d); cfg == nil || d.failed != 0 {
+		t.Errorf("valid config: %d checks failed", d.failed)
+	}
+
+	// An unparseable file fails once, not as an empty configuration
+	useConfig(t, "profiles:\n  default:\n    latitude: -6.2\n   longitude: 106.8\n")
+	d = &doctor{}
+	if cfg := checkConfig(d); cfg != nil || d.failed != 1 {
+		t.Errorf("broken config = %v, %d checks failed, want 1", cfg, d.failed)
+	}
+
+	useConfig(t, "")
+	d = &doctor{}
+	if cfg := checkConfig(d); cfg != nil || d.failed != 2 {
+		t.Errorf("missing config = %v, %d checks failed, want the file and the location", cfg, d.failed)
+	}
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"jadwalsalat/config"
+	"jadwalsalat/geocode"
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+)
+
+// Exit codes returned by salat, documented in the README
+const (
+	// ExitError is any failure without a more specific code
+	ExitError = 1
+	// ExitUsage means invalid flags, arguments, or an unknown command
+	ExitUsage = 2
+	// ExitConfigMissing means there is no usable configuration
+	ExitConfigMissing = 3
+	// ExitInvalidLocation means the coordinates, timezone, or location name are invalid
+	ExitInvalidLocation = 4
+	// ExitGeocodingFailed means the location could not be geocoded
+	ExitGeocodingFailed = 5
+	// ExitCalculationUndefined means a prayer time does not occur at the location
+	ExitCalculationUndefined = 6
+	// ExitConfigInvalid means the configuration has invalid values or cannot be parsed
+	ExitConfigInvalid = 7
+)
+
+// configError reports a missing, incomplete, or unreadable configuration
+type configError struct{ err error }
+
+func (e *configError) Error() string { return e.err.Error() }
+func (e *configError) Unwrap() error { return e.err }
+
+// locationError reports invalid coordinates, timezone, or location input
+type locationError struct{ err error }
+
+func (e *locationError) Error() string { return e.err.Error() }
+func (e *locationError) Unwrap() error { return e.err }
+
+// geocodingError reports a failed lookup of a location name or coordinates
+type geocodingError struct{ err error }
+
+func (e *geocodingError) Error() string { return e.err.Error() }
+func (e *geocodingError) Unwrap() error { return e.err }
+
+// usageError reports invalid flags or arguments
+type usageError struct{ err error }
+
+func (e *usageError) Error() string { return e.err.Error() }
+func (e *usageError) Unwrap() error { return e.err }
+
+// lookupError classifies a geocoding failure: a query without matches is an
+// invalid location, anything else means the provider could not be used
+func lookupError(err error) error {
+	var notFound *geocode.NotFoundError
+	if errors.As(err, &notFound) {
+		return &locationError{err}
+	}
+	return &geocodingError{err}
+}
+
+// loadError reports an error loading the configuration. Invalid values and
+// unreadable files are returned as is, so they are not mistaken for a
+// missing configuration.
+func loadError(err error) error {
+	var (
+		valErr  *config.ValidationError
+		fileErr *config.FileError
+	)
+	if errors.As(err, &valErr) || errors.As(err, &fileErr) {
+		return err
+	}
+	return &configError{i18n.Errorf("gagal memuat konfigurasi: %v", err)}
+}
+
+// exitCode returns the exit code for err
+func exitCode(err error) int {
+	var (
+		cfgErr   *configError
+		locErr   *locationError
+		geoErr   *geocodingError
+		usageErr *usageError
+		valErr   *config.ValidationError
+		fileErr  *config.FileError
+	)
+	switch {
+	case errors.As(err, &usageErr):
+		return ExitUsage
+	case errors.As(err, &valErr), errors.As(err, &fileErr):
+		return ExitConfigInvalid
+	case errors.As(err, &cfgErr):
+		return ExitConfigMissing
+	case errors.As(err, &locErr):
+		return ExitInvalidLocation
+	case errors.As(err, &geoErr):
+		return ExitGeocodingFailed
+	case errors.Is(err, salat.ErrUndefined):
+		return ExitCalculationUndefined
+	default:
+		return ExitError
+	}
+}
+
+// printError writes err to stderr with a hint on how to fix it
+func printError(err error) {
+	fmt.Fprintln(os.Stderr, i18n.Sprintf("Error: %v", err))
+
+	var (
+		cfgErr  *configError
+		valErr  *config.ValidationError
+		fileErr *config.FileError
+	)
+	switch {
+	case errors.As(err, &fileErr) && fileErr.Path == "":
+		fmt.Fprintln(os.Stderr, i18n.T("Periksa nilai di file konfigurasi dan variabel lingkungan SALAT_*, atau jalankan 'salat doctor' untuk pemeriksaan lengkap."))
+	case errors.As(err, &fileErr):
+		fmt.Fprintln(os.Stderr, i18n.T("Perbaiki dengan 'salat config edit', atau jalankan 'salat doctor' untuk pemeriksaan lengkap."))
+	case errors.As(err, &valErr):
+		fmt.Fprintln(os.Stderr, i18n.T("Perbaiki dengan 'salat config set', atau jalankan 'salat doctor' untuk pemeriksaan lengkap."))
+	case errors.As(err, &cfgErr):
+		fmt.Fprintln(os.Stderr, i18n.T("Jalankan 'salat setup' untuk mengatur lokasi."))
+	}
+	if errors.Is(err, salat.ErrUndefined) {
+		fmt.Fprintln(os.Stderr, i18n.T("Waktu sholat ini tidak terjadi di lokasi tersebut pada tanggal ini."))
+	}
+}
+
+// loadLocationConfig loads the configuration of commands that need a
+// location, along with its timezone
+func loadLocationConfig() (*config.Config, *time.Location, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return nil, nil, loadError(err)
+	}
+	if !cfg.HasLocation() {
+		return nil, nil, &configError{i18n.Errorf("konfigurasi lokasi belum ada")}
+	}
+
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, nil, &locationError{i18n.Errorf("timezone tidak valid: %v", err)}
+	}
+	return cfg, loc, nil
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"jadwalsalat/config"
+	"jadwalsalat/geocode"
+	"jadwalsalat/salat"
+
+	"github.com/spf13/cobra"
+)
+
+func TestExitCode(t *testing.T) {
+	base := errors.New("gagal")
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"generic", base, ExitError},
+		{"usage", &usageError{base}, ExitUsage},
+		{"config", &configError{base}, ExitConfigMissing},
+		{"location", &locationError{base}, ExitInvalidLocation},
+		{"geocoding", &geocodingError{base}, ExitGeocodingFailed},
+		{"undefined", fmt.Errorf("Isya: %w", salat.ErrUndefined), ExitCalculationUndefined},
+		{"validation", &config.ValidationError{}, ExitConfigInvalid},
+		{"unreadable file", &config.FileError{Path: "config.yaml", Err: base}, ExitConfigInvalid},
+		{"loading unreadable file", loadError(&config.FileError{Err: base}), ExitConfigInvalid},
+		{"loading", loadError(base), ExitConfigMissing},
+		{"wrapped location", fmt.Errorf("setup: %w", &locationError{base}), ExitInvalidLocation},
+		{"not found", lookupError(&geocode.NotFoundError{Query: "Atlantis"}), ExitInvalidLocation},
+		{"lookup failed", lookupError(base), ExitGeocodingFailed},
+		// An invalid configuration wrapped by a config error is still invalid
+		{"validation in config", &configError{&config.ValidationError{}}, ExitConfigInvalid},
+		{"usage first", &usageError{&locationError{base}}, ExitUsage},
+	}
+	for _, tt := range tests {
+		if got := exitCode(tt.err); got != tt.want {
+			t.Errorf("%s: exitCode = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+	if ExitError != 1 || ExitUsage != 2 || ExitConfigMissing != 3 || ExitInvalidLocation != 4 ||
+		ExitGeocodingFailed != 5 || ExitCalculationUndefined != 6 || ExitConfigInvalid != 7 {
+		t.Error("exit codes differ from the ones documented in the README")
+	}
+}
+
+func TestUsageArgs(t *testing.T) {
+	cmd := &cobra.Command{Use: "salat"}
+	sub := &cobra.Command{Use: "init", Args: cobra.ExactArgs(1), RunE: func(*cobra.Command, []string) error { return nil }}
+	cmd.AddCommand(sub)
+	usageArgs(cmd)
+
+	if err := sub.Args(sub, nil); exitCode(err) != ExitUsage {
+		t.Errorf("missing argument = %v (exit %d), want a usage error", err, exitCode(err))
+	}
+	if err := sub.Args(sub, []string{"bash"}); err != nil {
+		t.Errorf("valid argument = %v", err)
+	}
+
+	err := rootCmd.Args(rootCmd, []string{"shw"})
+	if exitCode(err) != ExitUsage || !strings.Contains(err.Error(), "show") {
+		t.Errorf("unknown command = %v (exit %d), want a usage error suggesting show", err, exitCode(err))
+	}
+	if err := rootCmd.Args(rootCmd, nil); err != nil {
+		t.Errorf("no arguments = %v", err)
+	}
+}
+
+func TestUnreadableConfig(t *testing.T) {
+	path := useConfig(t, "profiles:\n  default:\n    latitude: -6.2\n   longitude: 106.8\n")
+	_, _, err := loadLocationConfig()
+	if exitCode(err) != ExitConfigInvalid || !strings.Contains(err.Error(), path) || !strings.Contains(err.Error(), "line ") {
+		t.Errorf("broken file = %v (exit %d), want an invalid configuration naming the file and line", err, exitCode(err))
+	}
+
+	useConfig(t, testConfig)
+	t.Setenv("SALAT_LATITUDE", "abc")
+	config.BindEnv()
+	if _, _, err := loadLocationConfig(); exitCode(err) != ExitConfigInvalid {
+		t.Errorf("SALAT_LATITUDE=abc = %v (exit %d), want an invalid configuration", err, exitCode(err))
+	}
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"jadwalsalat/config"
+	"jadwalsalat/format"
+	"jadwalsalat/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+// addFormatFlag adds --format to a command that prints prayer times
+func addFormatFlag(cmd *cobra.Command) {
+	cmd.Flags().String("format", "", "Cetak dengan template Go atau nama template di konfigurasi (lihat README)")
+}
+
+// formatFlag returns the value of --format, empty when not given
+func formatFlag(cmd *cobra.Command) string {
+	value, _ := cmd.Flags().GetString("format")
+	return value
+}
+
+// formatTemplate returns the template text of --format: the named template
+// in the configuration, otherwise the value itself
+func formatTemplate(cfg *config.Config, value string) string {
+	if text, ok := cfg.Formats[strings.ToLower(value)]; ok {
+		return text
+	}
+	return value
+}
+
+// formatData builds the data of a --format template at now
+func formatData(cfg *config.Config, now time.Time) (*format.Data, error) {
+	// Status bars and prompts call this every few seconds
+	path, _ := config.SchedulePath()
+	schedule, err := config.CachedSchedule(path, cfg.CurrentProfile(), now)
+	if err != nil {
+		return nil, i18n.Errorf("gagal menghitung jadwal sholat: %w", err)
+	}
+	method := string(schedule.Method)
+	if method != cfg.Method {
+		method = fmt.Sprintf("%s (auto)", method)
+	}
+	loc := format.Location{
+		Name:      getLocationNameFromConfig(cfg),
+		Latitude:  cfg.Latitude,
+		Longitude: cfg.Longitude,
+		Timezone:  cfg.Timezone,
+		Method:    method,
+	}
+	data := format.New(now, schedule.Times, loc, i18n.Current())
+	if display.noEmoji {
+		data.DropEmoji()
+	}
+	return data, nil
+}
+
+// renderFormat applies the template text to the prayer times at now
+func renderFormat(cfg *config.Config, text string, now time.Time) (string, *format.Data, error) {
+	tmpl, err := format.Parse("format", text)
+	if err != nil {
+		return "", nil, &usageError{i18n.Errorf("template tidak valid: %v", err)}
+	}
+	data, err := formatData(cfg, now)
+	if err != nil {
+		return "", nil, err
+	}
+	var out bytes.Buffer
+	if err := format.Execute(&out, tmpl, data); err != nil {
+		return "", nil, &usageError{i18n.Errorf("gagal menjalankan template: %v", err)}
+	}
+	return out.String(), data, nil
+}
+
+// printFormat prints the prayer times through the --format template value
+func printFormat(value string) error {
+	cfg, loc, err := loadLocationConfig()
+	if err != nil {
+		return err
+	}
+	out, _, err := renderFormat(cfg, formatTemplate(cfg, value), time.Now().In(loc))
+	if err != nil {
+		return err
+	}
+	if !strings.HasSuffix(out, "\n") {
+		out += "\n"
+	}
+	fmt.Print(out)
+	return nil
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"jadwalsalat/config"
+	"jadwalsalat/geocode"
+	"jadwalsalat/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+// gazetteerCmd represents the gazetteer command
+var gazetteerCmd = &cobra.Command{
+	Use:   "gazetteer",
+	Short: "Kelola gazetteer untuk geocoding offline",
+	Long: `Gazetteer adalah daftar lokasi yang dipakai untuk mencari koordinat tanpa internet.
+
+Gazetteer bawaan berisi semua provinsi dan kabupaten/kota di Indonesia serta
+kota-kota besar dunia, tetapi belum berisi kecamatan. Data tambahan (misalnya
+daftar kecamatan) bisa diimpor dari file CSV dengan kolom:
+
+  name,kind,parent,country,lat,lon,aliases
+
+kind berisi provinsi, kabupaten, kota, kecamatan, atau city; aliases dipisah "|".`,
+}
+
+// gazetteerSearchCmd represents the gazetteer search command
+var gazetteerSearchCmd = &cobra.Command{
+	Use:   "search [lokasi]",
+	Short: "Cari lokasi di gazetteer offline",
+	Long: `Cari lokasi di gazetteer offline dan tampilkan hasil terbaik.
+
+Contoh penggunaan:
+  salat gazetteer search "Kabupaten Garut"
+  salat gazetteer search "Malang, Jawa Timur"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		limit, _ := cmd.Flags().GetInt("limit")
+		return searchGazetteer(args[0], limit)
+	},
+}
+
+// gazetteerImportCmd represents the gazetteer import command
+var gazetteerImportCmd = &cobra.Command{
+	Use:   "import [file|url]",
+	Short: "Impor file CSV gazetteer tambahan",
+	Long: `Impor file CSV gazetteer tambahan dari file lokal atau URL.
+
+File disimpan di direktori konfigurasi dan otomatis dipakai oleh
+geocoding offline.
+
+Contoh penggunaan:
+  salat gazetteer import kecamatan.csv
+  salat gazetteer import https://example.org/kecamatan-jabar.csv`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return importGazetteer(args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(gazetteerCmd)
+	gazetteerCmd.AddCommand(gazetteerSearchCmd)
+	gazetteerCmd.AddCommand(gazetteerImportCmd)
+	gazetteerSearchCmd.Flags().IntP("limit", "l", 5, "Jumlah hasil maksimum")
+}
+
+// gazetteerDir returns the directory holding imported gazetteer files
+func gazetteerDir() (string, error) {
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return geocode.GazetteerDir(configDir), nil
+}
+
+// searchGazetteer prints the best offline matches for query
+func searchGazetteer(query string, limit int) error {
+	dir, err := gazetteerDir()
+	if err != nil {
+		return err
+	}
+
+	gaz, err := geocode.LoadGazetteer(dir)
+	if err != nil {
+		return i18n.Errorf("gagal memuat gazetteer: %v", err)
+	}
+
+	matches := gaz.Search(query, limit)
+	if len(matches) == 0 {
+		return i18n.Errorf("lokasi %q tidak ditemukan di gazetteer (%d lokasi)", query, gaz.Len())
+	}
+
+	for i, m := range matches {
+		fmt.Printf("%d. %s (%.4f, %.4f)\n", i+1, m.Place.DisplayName(), m.Place.Latitude, m.Place.Longitude)
+	}
+	return nil
+}
+
+// importGazetteer copies a gazetteer CSV from a file or URL into the config directory
+func importGazetteer(source string) error {
+	var data []byte
+	var err error
+
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		fmt.Println(i18n.Sprintf("⬇️  Mengunduh %s...", source))
+		data, err = downloadFile(source)
+	} else {
+		data, err = os.ReadFile(source)
+	}
+	if err != nil {
+		return i18n.Errorf("gagal membaca gazetteer: %v", err)
+	}
+
+	count, err := geocode.ValidateGazetteer(bytes.NewReader(data))
+	if err != nil {
+		return i18n.Errorf("gazetteer tidak valid: %v", err)
+	}
+
+	dir, err := gazetteerDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	name := filepath.Base(source)
+	if i := strings.IndexAny(name, "?#"); i >= 0 {
+		name = name[:i]
+	}
+	if !strings.HasSuffix(strings.ToLower(name), ".csv") {
+		name += ".csv"
+	}
+
+	target := filepath.Join(dir, name)
+	if err := os.WriteFile(target, data, 0644); err != nil {
+		return i18n.Errorf("gagal menyimpan gazetteer: %v", err)
+	}
+
+	fmt.Println(i18n.Sprintf("✅ %d lokasi diimpor ke %s", count, target))
+	return nil
+}
+
+// downloadFile fetches url and returns the response body
+func downloadFile(url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", geocode.DefaultUserAgent)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, i18n.Errorf("server mengembalikan status %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
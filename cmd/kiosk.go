@@ -0,0 +1,434 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"jadwalsalat/config"
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+// announcementsFile is the default announcements file inside the config directory
+const announcementsFile = "announcements.txt"
+
+// kioskFrame is how often the kiosk redraws; the announcements scroll one
+// character per frame
+const kioskFrame = 250 * time.Millisecond
+
+// kioskCmd represents the kiosk command
+var kioskCmd = &cobra.Command{
+	Use:   "kiosk",
+	Short: "Tampilkan papan jadwal sholat untuk layar masjid",
+	Long: `Tampilkan papan jadwal sholat layar penuh untuk TV atau monitor masjid:
+jam dengan angka besar, countdown ke adzan berikutnya lalu ke iqamah, tanggal
+Masehi dan Hijriah, jadwal hari ini, dan pengumuman berjalan.
+
+Pengumuman dibaca dari file (default ~/.config/salat/announcements.txt), satu
+pengumuman per baris; baris yang diawali "#" diabaikan. File dibaca ulang
+otomatis saat diubah.
+
+Tanggal Hijriah memakai kalender tabular dan berganti setelah Maghrib. Geser
+dengan --hijri-offset jika berbeda dengan penetapan setempat.
+
+Contoh penggunaan:
+  salat kiosk --title "Masjid Al-Ikhlas"
+  salat kiosk --iqamah 10 --iqamah-for subuh=20,maghrib=5
+  salat kiosk --announcements /srv/masjid/pengumuman.txt --hijri-offset -1
+
+Tekan q untuk keluar.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts := kioskOptions{}
+		opts.title, _ = cmd.Flags().GetString("title")
+		opts.iqamah, _ = cmd.Flags().GetInt("iqamah")
+		iqamahFor, _ := cmd.Flags().GetStringToInt("iqamah-for")
+		var err error
+		if opts.iqamahFor, err = parseIqamahFor(iqamahFor); err != nil {
+			return err
+		}
+		opts.announcements, _ = cmd.Flags().GetString("announcements")
+		opts.hijriOffset, _ = cmd.Flags().GetInt("hijri-offset")
+		return runKiosk(opts)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(kioskCmd)
+	addOverrideFlags(kioskCmd)
+	kioskCmd.Flags().String("title", "", "Judul papan, misalnya nama masjid (default nama lokasi)")
+	kioskCmd.Flags().Int("iqamah", 10, "Jarak adzan ke iqamah dalam menit")
+	kioskCmd.Flags().StringToInt("iqamah-for", nil, "Jarak iqamah per waktu sholat, misalnya subuh=20,maghrib=5")
+	kioskCmd.Flags().String("announcements", "", "File pengumuman (default ~/.config/salat/announcements.txt)")
+	kioskCmd.Flags().Int("hijri-offset", 0, "Geser tanggal Hijriah sekian hari")
+}
+
+// kioskOptions are the settings of the display board
+type kioskOptions struct {
+	title         string
+	iqamah        int
+	iqamahFor     map[salat.Prayer]int
+	announcements string
+	hijriOffset   int
+}
+
+// runKiosk starts the display board in the alternate screen
+func runKiosk(opts kioskOptions) error {
+	if !isatty.IsTerminal(os.Stdout.Fd()) && !isatty.IsCygwinTerminal(os.Stdout.Fd()) {
+		return &usageError{i18n.Errorf("salat kiosk membutuhkan terminal")}
+	}
+	if opts.iqamah < 0 {
+		return &usageError{i18n.Errorf("--iqamah tidak boleh negatif")}
+	}
+
+	cfg, loc, err := loadLocationConfig()
+	if err != nil {
+		return err
+	}
+
+	if opts.announcements == "" {
+		configDir, err := config.GetConfigDir()
+		if err != nil {
+			return err
+		}
+		opts.announcements = filepath.Join(configDir, announcementsFile)
+	}
+	if opts.title == "" {
+		opts.title = getLocationNameFromConfig(cfg)
+	}
+
+	m := newKioskModel(cfg, loc, opts, time.Now())
+	if m.todayErr != nil {
+		return i18n.Errorf("gagal menghitung jadwal sholat: %w", m.todayErr)
+	}
+	if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+		return i18n.Errorf("gagal menjalankan kiosk: %v", err)
+	}
+	return nil
+}
+
+// parseIqamahFor turns the --iqamah-for minutes by prayer name into minutes
+// by prayer
+func parseIqamahFor(byName map[string]int) (map[salat.Prayer]int, error) {
+	iqamahFor := map[salat.Prayer]int{}
+	for name, minutes := range byName {
+		p, ok := salat.ParsePrayer(name)
+		if !ok || !p.HasAdzan() || minutes < 0 {
+			return nil, &usageError{i18n.Errorf("--iqamah-for tidak valid: %s=%d (gunakan subuh, dzuhur, ashar, maghrib, atau isya)", name, minutes)}
+		}
+		iqamahFor[p] = minutes
+	}
+	return iqamahFor, nil
+}
+
+// kioskFrameMsg is sent on every frame
+type kioskFrameMsg time.Time
+
+// kioskModel is the state of the display board
+type kioskModel struct {
+	cfg  *config.Config
+	loc  *time.Location
+	opts kioskOptions
+
+	now      time.Time
+	today    salat.PrayerTimes
+	tomorrow salat.PrayerTimes
+	todayErr error
+
+	announcement string
+	modTime      time.Time // of the announcements file when last read
+	checked      time.Time // when the announcements file was last checked
+	scroll       int
+
+	width  int
+	height int
+}
+
+// Styles of the display board
+var (
+	kioskTitleStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("14"))
+	kioskClockStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("15"))
+	kioskAdzanStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("10"))
+	kioskIqamahStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("11"))
+	kioskDateStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("7"))
+	kioskNextStyle     = lipgloss.NewStyle().Bold(true).Reverse(true)
+	kioskAnnounceStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("0")).Background(lipgloss.Color("11"))
+	kioskCellStyle     = lipgloss.NewStyle().Padding(0, 1)
+)
+
+// newKioskModel returns the display board at now
+func newKioskModel(cfg *config.Config, loc *time.Location, opts kioskOptions, now time.Time) kioskModel {
+	m := kioskModel{cfg: cfg, loc: loc, opts: opts, now: now.In(loc), width: 80, height: 24}
+	m.calculate()
+	m.readAnnouncements()
+	return m
+}
+
+// calculate computes the prayer times of today and tomorrow
+func (m *kioskModel) calculate() {
+	location := m.cfg.SalatLocation()
+	day := startOfDay(m.now)
+	m.today, m.todayErr = salat.TimesForDate(day, location)
+	if m.todayErr == nil {
+		m.tomorrow, m.todayErr = salat.TimesForDate(day.AddDate(0, 0, 1), location)
+	}
+}
+
+// readAnnouncements reloads the announcements file when it has changed
+func (m *kioskModel) readAnnouncements() {
+	m.checked = m.now
+	info, err := os.Stat(m.opts.announcements)
+	if err != nil {
+		m.announcement, m.modTime = "", time.Time{}
+		return
+	}
+	if info.ModTime().Equal(m.modTime) {
+		return
+	}
+	m.modTime = info.ModTime()
+
+	f, err := os.Open(m.opts.announcements)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	m.announcement = strings.Join(lines, "   •   ")
+	m.scroll = 0
+}
+
+func (m kioskModel) Init() tea.Cmd {
+	return kioskTick()
+}
+
+// kioskTick schedules the next frame
+func kioskTick() tea.Cmd {
+	return tea.Tick(kioskFrame, func(t time.Time) tea.Msg {
+		return kioskFrameMsg(t)
+	})
+}
+
+func (m kioskModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case kioskFrameMsg:
+		previous := m.now
+		m.now = time.Time(msg).In(m.loc)
+		if !startOfDay(previous).Equal(startOfDay(m.now)) {
+			m.calculate()
+		}
+		if m.now.Sub(m.checked) >= 10*time.Second {
+			m.readAnnouncements()
+		}
+		m.scroll++
+		return m, kioskTick()
+
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// iqamahMinutes returns the minutes from the adzan of prayer to its iqamah
+func (m kioskModel) iqamahMinutes(prayer salat.Prayer) int {
+	if minutes, ok := m.opts.iqamahFor[prayer]; ok {
+		return minutes
+	}
+	return m.opts.iqamah
+}
+
+// countdown returns what the board counts down to: the iqamah of prayer when
+// its adzan has just passed, otherwise the adzan of the next prayer
+func (m kioskModel) countdown() (prayer salat.Prayer, target time.Time, iqamah bool) {
+	times := m.today.All()
+	for _, p := range times {
+		if !p.Prayer.HasAdzan() || m.now.Before(p.Time) {
+			continue
+		}
+		if iq := p.Time.Add(time.Duration(m.iqamahMinutes(p.Prayer)) * time.Minute); m.now.Before(iq) {
+			return p.Prayer, iq, true
+		}
+	}
+
+	for _, p := range times {
+		if p.Prayer.HasAdzan() && p.Time.After(m.now) {
+			return p.Prayer, p.Time, false
+		}
+	}
+	return salat.Subuh, m.tomorrow.Subuh, false
+}
+
+func (m kioskModel) View() string {
+	if m.todayErr != nil {
+		return m.todayErr.Error()
+	}
+
+	prayer, target, iqamah := m.countdown()
+	label := i18n.Sprintf("Adzan %s", prayer.Name(i18n.Current()))
+	countdownStyle := kioskAdzanStyle
+	if iqamah {
+		label = i18n.Sprintf("Iqamah %s", prayer.Name(i18n.Current()))
+		countdownStyle = kioskIqamahStyle
+	}
+
+	// The Hijri day starts at Maghrib
+	hijriDay := m.now.AddDate(0, 0, m.opts.hijriOffset)
+	if !m.now.Before(m.today.Maghrib) {
+		hijriDay = hijriDay.AddDate(0, 0, 1)
+	}
+	date := fmt.Sprintf("%s  •  %s", i18n.Date(m.now), hijriDate(salat.ToHijri(hijriDay)))
+
+	remaining := target.Sub(m.now).Truncate(time.Second)
+	blocks := []string{
+		kioskTitleStyle.Render(m.opts.title),
+		"",
+		kioskClockStyle.Render(m.bigClock(i18n.LiveClock(m.now))),
+		kioskDateStyle.Render(date),
+		"",
+		countdownStyle.Render(fmt.Sprintf("%s • %s", label, i18n.Clock(target))),
+		countdownStyle.Render(m.big(formatClock(remaining))),
+		"",
+		m.schedule(),
+	}
+	content := lipgloss.JoinVertical(lipgloss.Center, blocks...)
+
+	// Keep the last line for the announcements
+	height := m.height
+	ticker := m.ticker()
+	if ticker != "" {
+		height--
+	}
+	board := lipgloss.Place(m.width, height, lipgloss.Center, lipgloss.Center, content)
+	if ticker == "" {
+		return board
+	}
+	return board + "\n" + ticker
+}
+
+// schedule renders today's prayer times in one row, highlighting the next adzan
+func (m kioskModel) schedule() string {
+	next, _, iqamah := m.countdown()
+	var cells []string
+	for _, p := range m.today.All() {
+		cell := kioskCellStyle.Render(fmt.Sprintf("%s %s", p.Prayer.Name(i18n.Current()), i18n.Clock(p.Time)))
+		if !iqamah && next == p.Prayer {
+			cell = kioskNextStyle.Render(cell)
+		}
+		cells = append(cells, cell)
+	}
+	row := strings.Join(cells, " ")
+	if lipgloss.Width(row) > m.width {
+		// Two rows on narrow screens
+		row = strings.Join(cells[:3], " ") + "\n" + strings.Join(cells[3:], " ")
+	}
+	return row
+}
+
+// ticker renders the visible part of the scrolling announcements
+func (m kioskModel) ticker() string {
+	if m.announcement == "" || m.width <= 0 {
+		return ""
+	}
+	loop := []rune(m.announcement + "   •   ")
+	text := loop
+	for len(text) < len(loop)+m.width {
+		text = append(text, loop...)
+	}
+	offset := m.scroll % len(loop)
+	return kioskAnnounceStyle.Render(string(text[offset : offset+m.width]))
+}
+
+// big renders s in large digits when the screen is wide enough
+func (m kioskModel) big(s string) string {
+	text := bigText(s)
+	if lipgloss.Width(text) > m.width || m.height < 24 {
+		return s
+	}
+	return text
+}
+
+// bigClock renders a clock such as "3:04:05 PM" in big digits, with the
+// AM/PM marker, which has no big glyphs, beside them
+func (m kioskModel) bigClock(s string) string {
+	digits, marker, ok := strings.Cut(s, " ")
+	text := m.big(digits)
+	if !ok || text == digits {
+		return s
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, text, "  "+marker)
+}
+
+// formatClock formats a duration as "01:23:45"
+func formatClock(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	return fmt.Sprintf("%02d:%02d:%02d", int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60)
+}
+
+// hijriDate formats a Hijri date in the current language, e.g. "7 Jumadil Awal 1448 H"
+func hijriDate(h salat.HijriDate) string {
+	return i18n.Sprintf("%d %s %d H", h.Day, i18n.T(h.MonthName()), h.Year)
+}
+
+// bigGlyphs are five-line glyphs for the characters of a clock
+var bigGlyphs = map[rune][5]string{
+	'0': {"█████", "█   █", "█   █", "█   █", "█████"},
+	'1': {"  █  ", " ██  ", "  █  ", "  █  ", " ███ "},
+	'2': {"█████", "    █", "█████", "█    ", "█████"},
+	'3': {"█████", "    █", " ████", "    █", "█████"},
+	'4': {"█   █", "█   █", "█████", "    █", "    █"},
+	'5': {"█████", "█    ", "█████", "    █", "█████"},
+	'6': {"█████", "█    ", "█████", "█   █", "█████"},
+	'7': {"█████", "    █", "   █ ", "  █  ", "  █  "},
+	'8': {"█████", "█   █", "█████", "█   █", "█████"},
+	'9': {"█████", "█   █", "█████", "    █", "█████"},
+	':': {"   ", " █ ", "   ", " █ ", "   "},
+	'-': {"    ", "    ", "███ ", "    ", "    "},
+	' ': {"  ", "  ", "  ", "  ", "  "},
+}
+
+// bigText renders s with bigGlyphs, one space between characters
+func bigText(s string) string {
+	var lines [5]strings.Builder
+	for i, r := range s {
+		glyph, ok := bigGlyphs[r]
+		if !ok {
+			glyph = bigGlyphs[' ']
+		}
+		for row := range lines {
+			if i > 0 {
+				lines[row].WriteString(" ")
+			}
+			lines[row].WriteString(glyph[row])
+		}
+	}
+	rows := make([]string, len(lines))
+	for i := range lines {
+		rows[i] = lines[i].String()
+	}
+	return strings.Join(rows, "\n")
+}
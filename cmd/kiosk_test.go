@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"jadwalsalat/salat"
+)
+
+// kioskAt returns a board for 12 March 2024 in Jakarta at the given clock time
+func kioskAt(t *testing.T, clock string, opts kioskOptions) kioskModel {
+	t.Helper()
+	loc := time.FixedZone("WIB", 7*3600)
+	at := func(day int, clock string) time.Time {
+		c, err := time.ParseInLocation("15:04", clock, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return time.Date(2024, time.March, day, c.Hour(), c.Minute(), 0, 0, loc)
+	}
+	times := func(day int) salat.PrayerTimes {
+		return salat.PrayerTimes{
+			Imsak:   at(day, "04:19"),
+			Subuh:   at(day, "04:29"),
+			Dzuhur:  at(day, "12:04"),
+			Ashar:   at(day, "15:11"),
+			Maghrib: at(day, "18:10"),
+			Isya:    at(day, "19:19"),
+		}
+	}
+	return kioskModel{opts: opts, now: at(12, clock), today: times(12), tomorrow: times(13)}
+}
+
+func TestKioskCountdown(t *testing.T) {
+	opts := kioskOptions{iqamah: 10, iqamahFor: map[salat.Prayer]int{salat.Maghrib: 5}}
+	tests := []struct {
+		clock  string
+		prayer salat.Prayer
+		target string
+		iqamah bool
+	}{
+		{"03:00", salat.Subuh, "04:29", false}, // Imsak has no adzan
+		{"04:20", salat.Subuh, "04:29", false},
+		{"04:29", salat.Subuh, "04:39", true}, // the adzan switches to the iqamah
+		{"04:38", salat.Subuh, "04:39", true},
+		{"04:39", salat.Dzuhur, "12:04", false}, // and back after the iqamah
+		{"18:12", salat.Maghrib, "18:15", true}, // per-prayer iqamah
+		{"18:15", salat.Isya, "19:19", false},
+		{"19:25", salat.Isya, "19:29", true},
+		{"21:00", salat.Subuh, "04:29", false}, // tomorrow
+	}
+	for _, tt := range tests {
+		prayer, target, iqamah := kioskAt(t, tt.clock, opts).countdown()
+		if prayer != tt.prayer || target.Format("15:04") != tt.target || iqamah != tt.iqamah {
+			t.Errorf("countdown at %s = %v %s iqamah %v, want %v %s iqamah %v",
+				tt.clock, prayer, target.Format("15:04"), iqamah, tt.prayer, tt.target, tt.iqamah)
+		}
+	}
+
+	// Without an iqamah the board goes straight to the next adzan
+	if prayer, _, iqamah := kioskAt(t, "04:30", kioskOptions{}).countdown(); prayer != salat.Dzuhur || iqamah {
+		t.Errorf("countdown without iqamah = %v iqamah %v, want Dzuhur", prayer, iqamah)
+	}
+}
+
+func TestBigText(t *testing.T) {
+	got := strings.Split(bigText("0:1"), "\n")
+	if len(got) != 5 {
+		t.Fatalf("bigText has %d lines, want 5", len(got))
+	}
+	for i, line := range got {
+		parts := []string{bigGlyphs['0'][i], bigGlyphs[':'][i], bigGlyphs['1'][i]}
+		if want := strings.Join(parts, " "); line != want {
+			t.Errorf("line %d = %q, want %q", i, line, want)
+		}
+	}
+
+	// Characters without a glyph become spaces
+	if got, want := bigText("x"), bigText(" "); got != want {
+		t.Errorf("bigText(x) = %q, want %q", got, want)
+	}
+	if got := bigText(""); got != "\n\n\n\n" {
+		t.Errorf("bigText of nothing = %q", got)
+	}
+}
+
+func TestKioskTicker(t *testing.T) {
+	m := kioskModel{announcement: "Kajian", width: 10}
+	// The loop "Kajian   •   " is 13 runes long
+	tests := []struct {
+		scroll int
+		want   string
+	}{
+		{0, "Kajian   •"},
+		{3, "ian   •   "},
+		{8, " •   Kajia"},
+		{13, "Kajian   •"}, // wraps around after one loop
+		{13*4 + 8, " •   Kajia"},
+	}
+	for _, tt := range tests {
+		m.scroll = tt.scroll
+		if got := m.ticker(); got != tt.want {
+			t.Errorf("ticker at %d = %q, want %q", tt.scroll, got, tt.want)
+		}
+	}
+
+	// Wider than the text, the announcement repeats
+	m = kioskModel{announcement: "Ab", width: 20, scroll: 1}
+	if got, want := m.ticker(), "b   •   Ab   •   Ab "; got != want {
+		t.Errorf("wide ticker = %q, want %q", got, want)
+	}
+	if got := (kioskModel{width: 20}).ticker(); got != "" {
+		t.Errorf("ticker without announcements = %q", got)
+	}
+}
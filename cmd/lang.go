@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"jadwalsalat/i18n"
+
+	"github.com/spf13/viper"
+)
+
+// langFlag is the language chosen for this run with --lang
+var langFlag string
+
+// outputLanguage returns the language of the output: --lang, otherwise the
+// language setting (SALAT_LANGUAGE or the config file), otherwise the locale
+func outputLanguage() i18n.Lang {
+	for _, value := range []string{langFlag, viper.GetString("language")} {
+		if value == "" {
+			continue
+		}
+		if l, err := i18n.Parse(value); err == nil {
+			return l
+		}
+	}
+	return i18n.FromEnv()
+}
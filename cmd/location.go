@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"jadwalsalat/config"
+	"jadwalsalat/geocode"
+	"jadwalsalat/i18n"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// maxLocationCandidates is how many matches are offered when a name is ambiguous
+const maxLocationCandidates = 5
+
+// nonInteractive disables every prompt, set by setup --non-interactive
+var nonInteractive bool
+
+// canPrompt reports whether questions can be asked: prompting is disabled
+// with --non-interactive or when stdin is not a terminal, e.g. in Docker or
+// provisioning scripts, where a prompt would block forever
+func canPrompt() bool {
+	if nonInteractive {
+		return false
+	}
+	fd := os.Stdin.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// resolveLocation geocodes query and, when several places match, asks the
+// user to pick one. With first set the best match is taken without asking;
+// when prompting is not possible an ambiguous query is an error.
+func resolveLocation(apiType, query string, first bool) (geocode.Result, error) {
+	limit := maxLocationCandidates
+	if first {
+		limit = 1
+	}
+
+	results, err := config.SearchLocations(apiType, query, limit)
+	if err != nil {
+		return geocode.Result{}, lookupError(err)
+	}
+	if len(results) == 1 || first {
+		return results[0], nil
+	}
+
+	options := make([]string, len(results))
+	for i, r := range results {
+		options[i] = r.Label()
+	}
+
+	if !canPrompt() {
+		var b strings.Builder
+		b.WriteString(i18n.Sprintf("lokasi %q cocok dengan %d tempat:", query, len(results)))
+		for i, o := range options {
+			fmt.Fprintf(&b, "\n  %d. %s", i+1, o)
+		}
+		b.WriteString("\n" + i18n.T("gunakan nama yang lebih spesifik atau --first untuk memakai hasil teratas"))
+		return geocode.Result{}, &locationError{fmt.Errorf("%s", b.String())}
+	}
+
+	choice := 0
+	prompt := &survey.Select{
+		Message: i18n.Sprintf("Ditemukan %d lokasi untuk %q, pilih salah satu:", len(results), query),
+		Options: options,
+	}
+	if err := survey.AskOne(prompt, &choice); err != nil {
+		return geocode.Result{}, i18n.Errorf("gagal membaca pilihan lokasi: %v", err)
+	}
+	return results[choice], nil
+}
+
+// lookupLocation resolves a place name or "lat,lon" coordinates. Coordinates
+// are named by reverse geocoding, falling back to the coordinates themselves.
+func lookupLocation(apiType, value string, first bool) (geocode.Result, error) {
+	if lat, lon, ok := parseCoordinates(value); ok {
+		if err := checkCoordinates(lat, lon); err != nil {
+			return geocode.Result{}, err
+		}
+		name, err := config.ReverseGeocode(apiType, lat, lon)
+		if err != nil {
+			name = i18n.Sprintf("Lokasi (%.6f, %.6f)", lat, lon)
+		}
+		return geocode.Result{Latitude: lat, Longitude: lon, Name: name}, nil
+	}
+
+	fmt.Println(i18n.T("🔍 Mencari koordinat lokasi..."))
+	return resolveLocation(apiType, value, first)
+}
+
+// checkCoordinates reports coordinates outside the valid range
+func checkCoordinates(lat, lon float64) error {
+	if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return &locationError{i18n.Errorf("koordinat di luar jangkauan: %.6f, %.6f (latitude -90..90, longitude -180..180)", lat, lon)}
+	}
+	return nil
+}
+
+// parseCoordinates parses "lat,lon"
+func parseCoordinates(value string) (lat, lon float64, ok bool) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	lat, err1 := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	lon, err2 := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	return lat, lon, err1 == nil && err2 == nil
+}
+
+// locationTimezone returns the timezone at the coordinates, or the system
+// timezone when the location's zone cannot be determined
+func locationTimezone(lat, lon float64) (string, error) {
+	if tz, err := geocode.Timezone(lat, lon); err == nil {
+		return tz, nil
+	}
+	loc, err := config.DetectTimezone()
+	if err != nil {
+		return "", err
+	}
+	return loc.String(), nil
+}
+
+// timezoneMatches reports whether two timezone names keep the same time
+func timezoneMatches(a, b string) bool {
+	if a == b {
+		return true
+	}
+	locA, errA := time.LoadLocation(a)
+	locB, errB := time.LoadLocation(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return geocode.SameOffset(locA, locB, time.Now())
+}
+
+// warnTimezoneMismatch prints a warning when timezone is not the zone of the coordinates
+func warnTimezoneMismatch(timezone string, lat, lon float64) {
+	want, err := geocode.Timezone(lat, lon)
+	if err != nil || timezoneMatches(timezone, want) {
+		return
+	}
+	fmt.Println(i18n.Sprintf("⚠️  Peringatan: timezone %s tidak sesuai dengan lokasi (%s)", timezone, want))
+	fmt.Println(i18n.Sprintf("   Jalankan 'salat config set timezone %s' untuk menyesuaikan.", want))
+}
+
+// overrideFlags maps the flags that override the location for one run to
+// their config keys
+var overrideFlags = map[string]string{
+	"lat":    "latitude",
+	"lon":    "longitude",
+	"method": "method",
+	"tz":     "timezone",
+}
+
+// addOverrideFlags adds --lat, --lon, --method, and --tz to commands that
+// show prayer times. With coordinates no config file is needed.
+func addOverrideFlags(cmds ...*cobra.Command) {
+	for _, cmd := range cmds {
+		cmd.Flags().Float64("lat", 0, "Garis lintang untuk sekali jalan")
+		cmd.Flags().Float64("lon", 0, "Garis bujur untuk sekali jalan")
+		cmd.Flags().String("method", "", "Metode perhitungan untuk sekali jalan")
+		cmd.Flags().String("tz", "", "Timezone untuk sekali jalan (default dari koordinat)")
+	}
+}
+
+// bindOverrideFlags checks the override flags of the command being run and
+// binds them to their config keys
+func bindOverrideFlags(cmd *cobra.Command) error {
+	flags := cmd.Flags()
+	if flags.Lookup("lat") == nil {
+		return nil
+	}
+
+	if flags.Changed("lat") || flags.Changed("lon") {
+		lat, _ := flags.GetFloat64("lat")
+		lon, _ := flags.GetFloat64("lon")
+		if err := checkCoordinates(lat, lon); err != nil {
+			return err
+		}
+	}
+	if method, _ := flags.GetString("method"); flags.Changed("method") && !config.ValidMethod(method) {
+		return &usageError{i18n.Errorf("metode tidak valid: %s", method)}
+	}
+	if tz, _ := flags.GetString("tz"); flags.Changed("tz") {
+		if _, err := time.LoadLocation(tz); err != nil {
+			return &locationError{i18n.Errorf("timezone tidak valid: %s", tz)}
+		}
+	}
+
+	for flag, key := range overrideFlags {
+		if err := viper.BindPFlag(key, flags.Lookup(flag)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
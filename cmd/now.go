@@ -5,10 +5,10 @@ import (
 	"strings"
 	"time"
 
-	"jadwalsalat/config"
+	"jadwalsalat/i18n"
 	"jadwalsalat/salat"
+	"jadwalsalat/theme"
 
-	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -17,94 +17,66 @@ var nowCmd = &cobra.Command{
 	Use:   "now",
 	Short: "Tampilkan waktu sholat saat ini dan countdown",
 	Long:  `Tampilkan waktu sholat saat ini dan countdown ke waktu sholat berikutnya dengan tampilan ringkas.`,
-	Run: func(cmd *cobra.Command, args []string) {
-		showCurrentPrayer()
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if value := formatFlag(cmd); value != "" {
+			return printFormat(value)
+		}
+		return showCurrentPrayer()
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(nowCmd)
+	addOverrideFlags(nowCmd)
+	addFormatFlag(nowCmd)
 }
 
 // showCurrentPrayer displays the current prayer time and countdown to next prayer
-func showCurrentPrayer() {
+func showCurrentPrayer() error {
 	// Load configuration
-	cfg, err := config.LoadConfig()
-	if err != nil {
-		fmt.Printf("Error loading configuration: %v\n", err)
-		fmt.Println("Run 'salat setup' to configure the application.")
-		return
-	}
-
-	// Parse timezone
-	loc, err := time.LoadLocation(cfg.Timezone)
+	cfg, loc, err := loadLocationConfig()
 	if err != nil {
-		fmt.Printf("Error parsing timezone: %v\n", err)
-		return
+		return err
 	}
 
 	// Get current time in the configured timezone
 	now := time.Now().In(loc)
 
 	// Calculate prayer times for today
-	location := salat.Location{
-		Latitude:  cfg.Latitude,
-		Longitude: cfg.Longitude,
-		Method:    salat.CalculationMethod(cfg.Method),
-	}
+	location := cfg.SalatLocation()
 
 	times, err := salat.TimesForDate(now, location)
 	if err != nil {
-		fmt.Printf("Error calculating prayer times: %v\n", err)
-		return
+		return i18n.Errorf("gagal menghitung jadwal sholat: %w", err)
 	}
 
 	// Get current and next prayer time
-	currentName, hasActive := salat.GetCurrentPrayer(now, times)
-	nextName, nextTime := salat.GetNextPrayer(now, times)
-	remaining := nextTime.Sub(now)
-
-	// Format remaining time
-	hours := int(remaining.Hours())
-	minutes := int(remaining.Minutes()) % 60
-	seconds := int(remaining.Seconds()) % 60
-	remainStr := fmt.Sprintf("%dh %02dm %02ds", hours, minutes, seconds)
-	if hours == 0 {
-		remainStr = fmt.Sprintf("%dm %02ds", minutes, seconds)
+	current, hasActive := salat.GetCurrentPrayer(now, times)
+	next := salat.GetNextPrayer(now, times)
+	remaining := next.Time.Sub(now)
+
+	remainStr := i18n.LongDuration(remaining)
+
+	if display.screenReader {
+		speakCountdown(cfg, now, times)
+		return nil
 	}
 
-	// Create color objects
-	headerColor := color.New(color.FgHiCyan, color.Bold)
-	activeColor := color.New(color.FgHiGreen, color.Bold)
-	nextColor := color.New(color.FgHiYellow, color.Bold)
-	timeColor := color.New(color.FgHiWhite)
+	colors, err := loadThemeColors(theme.Default)
+	if err != nil {
+		return err
+	}
 
 	// Print header with current date and time
-	headerColor.Printf("\n🕌 Jadwal Sholat - %s\n", now.Format("15:04:05"))
-	fmt.Printf("📍 %s • %s\n\n", getLocationNameFromConfig(cfg), now.Format("Monday, 2 January 2006"))
+	colors.header.Println("\n" + i18n.Sprintf("🕌 Jadwal Sholat - %s", i18n.LiveClock(now)))
+	fmt.Printf("%s%s • %s\n\n", icon("📍"), getLocationNameFromConfig(cfg), i18n.Date(now))
 
 	// Print current prayer info
 	if hasActive {
-		currentEmoji := salat.GetPrayerEmoji(currentName)
-		activeColor.Printf("Waktu sholat saat ini: %s %s\n", currentEmoji, currentName)
-
-		// Find the time for current prayer
-		var currentTime time.Time
-		switch currentName {
-		case "Subuh":
-			currentTime = times.Subuh
-		case "Dzuhur":
-			currentTime = times.Dzuhur
-		case "Ashar":
-			currentTime = times.Ashar
-		case "Maghrib":
-			currentTime = times.Maghrib
-		case "Isya":
-			currentTime = times.Isya
-		}
+		colors.active.Println(i18n.Sprintf("Waktu sholat saat ini: %s%s", prayerIcon(current.Prayer), current.Prayer.Name(i18n.Current())))
 
 		// Calculate elapsed time since current prayer started
-		elapsed := now.Sub(currentTime)
+		elapsed := now.Sub(current.Time)
 		elapsedHours := int(elapsed.Hours())
 		elapsedMinutes := int(elapsed.Minutes()) % 60
 		elapsedSeconds := int(elapsed.Seconds()) % 60
@@ -113,48 +85,30 @@ func showCurrentPrayer() {
 			elapsedStr = fmt.Sprintf("%dm %02ds", elapsedMinutes, elapsedSeconds)
 		}
 
-		timeColor.Printf("Dimulai: %s (%s yang lalu)\n", currentTime.Format("15:04"), elapsedStr)
+		fmt.Println(i18n.Sprintf("Dimulai: %s (%s yang lalu)", i18n.Clock(current.Time), elapsedStr))
 	} else {
-		activeColor.Printf("Tidak ada waktu sholat saat ini\n")
+		colors.active.Println(i18n.T("Tidak ada waktu sholat saat ini"))
 	}
 
 	// Print next prayer info
 	fmt.Println()
-	nextEmoji := salat.GetPrayerEmoji(strings.Split(nextName, " ")[0]) // Get prayer name without "(besok)"
-	nextColor.Printf("Sholat berikutnya: %s %s\n", nextEmoji, nextName)
-	timeColor.Printf("Waktu: %s (dalam %s)\n", nextTime.Format("15:04"), remainStr)
+	colors.next.Println(i18n.Sprintf("Sholat berikutnya: %s%s", prayerIcon(next.Prayer), next.Label(i18n.Current())))
+	fmt.Println(i18n.Sprintf("Waktu: %s (dalam %s)", i18n.Clock(next.Time), remainStr))
 
 	// Create simple progress bar
 	progressBarWidth := 30
 	var intervalDuration time.Duration
 
 	// Determine the interval (time between previous prayer and next prayer)
-	prayerTimes := []struct {
-		name string
-		time time.Time
-	}{
-		{"Subuh", times.Subuh},
-		{"Dzuhur", times.Dzuhur},
-		{"Ashar", times.Ashar},
-		{"Maghrib", times.Maghrib},
-		{"Isya", times.Isya},
-		{"Subuh (besok)", times.Subuh.Add(24 * time.Hour)},
-	}
-
 	var prevPrayer time.Time
-	for i, prayer := range prayerTimes {
-		if prayer.name == nextName {
-			if i == 0 {
-				// If it's the first prayer of the day, use midnight as the previous time
-				prevPrayer = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
-			} else {
-				prevPrayer = prayerTimes[i-1].time
-			}
-			break
-		}
+	if prev, ok := salat.GetPreviousPrayer(next, times); ok {
+		prevPrayer = prev.Time
+	} else {
+		// If it's the first prayer of the day, use midnight as the previous time
+		prevPrayer = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
 	}
 
-	intervalDuration = nextTime.Sub(prevPrayer)
+	intervalDuration = next.Time.Sub(prevPrayer)
 	elapsed := now.Sub(prevPrayer)
 
 	var progress float64
@@ -176,13 +130,12 @@ func showCurrentPrayer() {
 
 	// Print progress bar
 	fmt.Println()
-	progressColor := color.New(color.FgHiGreen)
-	emptyColor := color.New(color.FgHiBlack)
 
 	// Print progress bar with percentage
-	fmt.Printf("%3.0f%% ", progress*100)
-	progressColor.Print(strings.Repeat("█", filledWidth))
-	emptyColor.Print(strings.Repeat("░", emptyWidth))
+	colors.progress.Printf("%3.0f%% ", progress*100)
+	colors.progress.Print(strings.Repeat("█", filledWidth))
+	colors.passed.Print(strings.Repeat("░", emptyWidth))
 	fmt.Println()
 	fmt.Println()
+	return nil
 }
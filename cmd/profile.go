@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"fmt"
+
+	"jadwalsalat/config"
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+
+	"github.com/spf13/cobra"
+)
+
+// profileCmd represents the profile command
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Kelola profil lokasi",
+	Long: `Profil menyimpan beberapa lokasi bernama, misalnya kantor, rumah, dan kampung,
+masing-masing dengan koordinat, timezone, metode, dan penyesuaian waktunya sendiri.
+
+Profil aktif dipakai oleh semua perintah. Gunakan --profile untuk memakai
+profil lain sekali jalan tanpa mengganti profil aktif:
+
+  salat show --profile kampung
+
+Lokasi hasil 'salat setup' disimpan sebagai profil "default".`,
+}
+
+// profileAddCmd represents the profile add command
+var profileAddCmd = &cobra.Command{
+	Use:   "add [nama] [lokasi]",
+	Short: "Tambah profil lokasi",
+	Long: `Tambah profil lokasi dari alamat atau koordinat.
+
+Timezone ditentukan dari lokasi dan metode perhitungan default-nya auto.
+
+Contoh penggunaan:
+  salat profile add kantor "Sudirman, Jakarta"
+  salat profile add rumah -- "-6.9,107.6"
+  salat profile add kampung "Kabupaten Garut" --offline --method Kemenag`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		method, _ := cmd.Flags().GetString("method")
+		offline, _ := cmd.Flags().GetBool("offline")
+		first, _ := cmd.Flags().GetBool("first")
+		return addProfile(args[0], args[1], method, offline, first)
+	},
+}
+
+// profileUseCmd represents the profile use command
+var profileUseCmd = &cobra.Command{
+	Use:   "use [nama]",
+	Short: "Ganti profil aktif",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return useProfile(args[0])
+	},
+}
+
+// profileListCmd represents the profile list command
+var profileListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "Tampilkan daftar profil",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return listProfiles()
+	},
+}
+
+// profileRemoveCmd represents the profile remove command
+var profileRemoveCmd = &cobra.Command{
+	Use:     "remove [nama]",
+	Aliases: []string{"rm"},
+	Short:   "Hapus profil",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return removeProfile(args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(profileCmd)
+	profileCmd.AddCommand(profileAddCmd)
+	profileCmd.AddCommand(profileUseCmd)
+	profileCmd.AddCommand(profileListCmd)
+	profileCmd.AddCommand(profileRemoveCmd)
+	profileAddCmd.Flags().StringP("method", "m", string(salat.Auto), "Metode perhitungan")
+	profileAddCmd.Flags().Bool("offline", false, "Cari lokasi di gazetteer offline tanpa internet")
+	profileAddCmd.Flags().Bool("first", false, "Langsung pakai hasil pencarian lokasi teratas tanpa bertanya")
+}
+
+// addProfile geocodes a location and saves it as a new profile
+func addProfile(name, value, method string, offline, first bool) error {
+	if err := config.ValidateProfileName(name); err != nil {
+		return &usageError{err}
+	}
+	if !config.ValidMethod(method) {
+		return &usageError{i18n.Errorf("metode tidak valid: %s", method)}
+	}
+
+	cfg, err := config.LoadBaseConfig()
+	if err != nil {
+		return err
+	}
+	if _, ok := cfg.Profiles[name]; ok {
+		return i18n.Errorf("profil %q sudah ada, hapus dulu dengan 'salat profile remove %s'", name, name)
+	}
+
+	apiType := cfg.GeocodingAPI
+	if apiType == "" {
+		apiType = "nominatim"
+	}
+	if offline {
+		apiType = config.OfflineGeocoder
+	}
+
+	result, err := lookupLocation(apiType, value, first)
+	if err != nil {
+		return i18n.Errorf("tidak dapat menemukan lokasi: %w", err)
+	}
+	timezone, err := locationTimezone(result.Latitude, result.Longitude)
+	if err != nil {
+		return &locationError{i18n.Errorf("gagal mendeteksi timezone: %v", err)}
+	}
+
+	if cfg.Profiles == nil {
+		cfg.Profiles = map[string]config.Profile{}
+	}
+	cfg.Profiles[name] = config.Profile{
+		Timezone:     timezone,
+		Latitude:     result.Latitude,
+		Longitude:    result.Longitude,
+		Method:       method,
+		LocationName: result.Name,
+	}
+	if err := config.SaveConfig(cfg); err != nil {
+		return i18n.Errorf("gagal menyimpan konfigurasi: %v", err)
+	}
+
+	fmt.Println(i18n.Sprintf("✅ Profil %s ditambahkan: %s (%.6f, %.6f) • %s", name, result.Name, result.Latitude, result.Longitude, timezone))
+	fmt.Println(i18n.Sprintf("Jalankan 'salat profile use %s' untuk memakainya.", name))
+	return nil
+}
+
+// useProfile makes name the active profile
+func useProfile(name string) error {
+	cfg, err := config.LoadBaseConfig()
+	if err != nil {
+		return err
+	}
+	selected := *cfg
+	if err := selected.UseProfile(name); err != nil {
+		return i18n.Errorf("profil %q tidak ditemukan", name)
+	}
+
+	// The top-level location of an unmigrated file is stored as no active profile
+	cfg.ActiveProfile = selected.Profile
+	if err := config.SaveConfig(cfg); err != nil {
+		return i18n.Errorf("gagal menyimpan konfigurasi: %v", err)
+	}
+
+	fmt.Println(i18n.Sprintf("Profil aktif: %s (%s)", name, getLocationNameFromConfig(&selected)))
+	return nil
+}
+
+// listProfiles prints the saved profiles, marking the one in effect
+func listProfiles() error {
+	cfg, err := config.LoadBaseConfig()
+	if err != nil {
+		return err
+	}
+
+	active := cfg.SelectedProfile()
+	if _, ok := cfg.Profiles[active]; !ok {
+		active = config.DefaultProfile
+	}
+
+	names := cfg.ProfileNames()
+	if _, ok := cfg.Profiles[config.DefaultProfile]; !ok && (cfg.Latitude != 0 || cfg.Longitude != 0) {
+		names = append([]string{config.DefaultProfile}, names...)
+	}
+	if len(names) == 0 {
+		fmt.Println(i18n.T("Belum ada profil. Tambahkan dengan 'salat profile add [nama] [lokasi]'."))
+		return nil
+	}
+
+	for _, name := range names {
+		p := *cfg
+		if err := p.UseProfile(name); err != nil {
+			return err
+		}
+		marker := " "
+		if name == active {
+			marker = "*"
+		}
+		fmt.Printf("%s %-12s %s (%.4f, %.4f) • %s • %s\n", marker, name, getLocationNameFromConfig(&p), p.Latitude, p.Longitude, p.Timezone, methodLabel(&p))
+	}
+	return nil
+}
+
+// removeProfile deletes a profile; removing the active profile switches
+// back to the default profile
+func removeProfile(name string) error {
+	cfg, err := config.LoadBaseConfig()
+	if err != nil {
+		return err
+	}
+	if _, ok := cfg.Profiles[name]; !ok {
+		return i18n.Errorf("profil %q tidak ditemukan", name)
+	}
+	if name == config.DefaultProfile {
+		return &usageError{i18n.Errorf("profil default tidak bisa dihapus, ubah dengan 'salat setup'")}
+	}
+
+	delete(cfg.Profiles, name)
+	if cfg.ActiveProfile == name {
+		cfg.ActiveProfile = ""
+	}
+	if err := config.SaveConfig(cfg); err != nil {
+		return i18n.Errorf("gagal menyimpan konfigurasi: %v", err)
+	}
+
+	fmt.Println(i18n.Sprintf("Profil %s dihapus", name))
+	return nil
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"jadwalsalat/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+// defaultPromptFormat is the prompt segment when --format is not given
+const defaultPromptFormat = "{{with .Next.Emoji}}{{.}} {{end}}{{.Next.Name}} {{.Next.In | short}}"
+
+// promptShells are the shells 'salat prompt init' has snippets for
+var promptShells = []string{"bash", "zsh", "fish", "starship"}
+
+// promptCmd represents the prompt command
+var promptCmd = &cobra.Command{
+	Use:   "prompt",
+	Short: "Segmen singkat sholat berikutnya untuk prompt shell",
+	Long: `Cetak segmen singkat seperti "🌇 Maghrib 12m" untuk prompt shell. Jadwal
+dibaca dari cache harian di direktori konfigurasi, yang dihitung ulang saat
+tanggal atau pengaturan lokasi berubah, jadi prompt tetap cepat.
+
+Pasang di shell dengan 'salat prompt init':
+  eval "$(salat prompt init bash)"    # ~/.bashrc
+  eval "$(salat prompt init zsh)"     # ~/.zshrc
+  salat prompt init fish | source     # ~/.config/fish/config.fish
+  salat prompt init starship >> ~/.config/starship.toml
+
+Contoh penggunaan:
+  salat prompt
+  salat prompt --within 30
+  salat prompt --format '{{.Next.Name}} {{.Next.Clock}}'`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		within, _ := cmd.Flags().GetInt("within")
+		return printPrompt(formatFlag(cmd), time.Duration(within)*time.Minute)
+	},
+}
+
+// promptInitCmd represents the prompt init command
+var promptInitCmd = &cobra.Command{
+	Use:       "init [shell]",
+	Short:     "Cetak snippet prompt untuk bash, zsh, fish, atau starship",
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: promptShells,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		snippet, ok := promptSnippets[strings.ToLower(args[0])]
+		if !ok {
+			return &usageError{i18n.Errorf("shell tidak dikenal %q, pilih salah satu dari: %s", args[0], strings.Join(promptShells, ", "))}
+		}
+		fmt.Print(snippet)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(promptCmd)
+	promptCmd.AddCommand(promptInitCmd)
+	addOverrideFlags(promptCmd)
+	addFormatFlag(promptCmd)
+	promptCmd.Flags().Int("within", 0, "Hanya tampil sekian menit sebelum waktu sholat (0: selalu)")
+}
+
+// printPrompt prints the prompt segment of the next prayer, or nothing when
+// it is further away than within
+func printPrompt(formatValue string, within time.Duration) error {
+	cfg, loc, err := loadLocationConfig()
+	if err != nil {
+		return err
+	}
+	text := defaultPromptFormat
+	if formatValue != "" {
+		text = formatTemplate(cfg, formatValue)
+	}
+	out, data, err := renderFormat(cfg, text, time.Now().In(loc))
+	if err != nil {
+		return err
+	}
+	if within > 0 && data.Next.In > within {
+		return nil
+	}
+	fmt.Println(strings.TrimRight(out, "\n"))
+	return nil
+}
+
+// promptSnippets put the prompt segment in front of each shell's prompt.
+// Errors are hidden so a missing configuration never breaks the prompt.
+var promptSnippets = map[string]string{
+	"bash": `__salat_prompt() {
+  local segment
+  segment=$(salat prompt 2>/dev/null) && [ -n "$segment" ] && printf '%s ' "$segment"
+}
+case "$PS1" in
+  *__salat_prompt*) ;;
+  *) PS1='$(__salat_prompt)'"$PS1" ;;
+esac
+`,
+	"zsh": `__salat_prompt() {
+  local segment
+  segment=$(salat prompt 2>/dev/null) && [[ -n $segment ]] && print -rn -- "${segment//\%/%%} "
+}
+setopt prompt_subst
+[[ $PROMPT == *__salat_prompt* ]] || PROMPT='$(__salat_prompt)'$PROMPT
+`,
+	"fish": `if not functions -q __salat_original_prompt
+    functions -c fish_prompt __salat_original_prompt
+    function fish_prompt
+        set -l segment (salat prompt 2>/dev/null)
+        and test -n "$segment"
+        and printf '%s ' $segment
+        __salat_original_prompt
+    end
+end
+`,
+	"starship": `[custom.salat]
+command = "salat prompt"
+when = true
+shell = ["sh"]
+format = "[$output]($style) "
+style = "bold green"
+`,
+}
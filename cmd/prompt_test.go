@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPromptSnippets(t *testing.T) {
+	for _, shell := range promptShells {
+		snippet, ok := promptSnippets[shell]
+		if !ok {
+			t.Errorf("no snippet for %s", shell)
+			continue
+		}
+		if !strings.Contains(snippet, "salat prompt") {
+			t.Errorf("%s snippet does not run salat prompt:\n%s", shell, snippet)
+		}
+		if !strings.HasSuffix(snippet, "\n") {
+			t.Errorf("%s snippet does not end with a newline", shell)
+		}
+	}
+	if len(promptSnippets) != len(promptShells) {
+		t.Errorf("%d snippets for %d shells", len(promptSnippets), len(promptShells))
+	}
+
+	err := promptInitCmd.RunE(promptInitCmd, []string{"tcsh"})
+	if exitCode(err) != ExitUsage {
+		t.Errorf("unknown shell = %v (exit %d), want a usage error", err, exitCode(err))
+	}
+}
+
+// TestPromptSnippetBash loads the bash snippet twice, with a fake salat on
+// the PATH, and checks the segment is put in front of the prompt once
+func TestPromptSnippetBash(t *testing.T) {
+	if _, err := exec.LookPath("bash"); err != nil {
+		t.Skip("bash not installed")
+	}
+	bin := t.TempDir()
+	fake := "#!/bin/sh\necho 'Maghrib 12m'\n"
+	if err := os.WriteFile(filepath.Join(bin, "salat"), []byte(fake), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	cmd := exec.Command("bash", "-c", `PS1='$ '; eval "$SNIPPET"; eval "$SNIPPET"; echo "${PS1@P}"`)
+	cmd.Env = append(os.Environ(), "SNIPPET="+promptSnippets["bash"])
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+	if got, want := strings.TrimSpace(string(out)), "Maghrib 12m $"; got != want {
+		t.Errorf("prompt = %q, want %q", got, want)
+	}
+}
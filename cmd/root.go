@@ -5,14 +5,17 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"jadwalsalat/config"
+	"jadwalsalat/i18n"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var cfgFile string
+var profileName string
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -20,39 +23,101 @@ var rootCmd = &cobra.Command{
 	Short: "Aplikasi CLI untuk jadwal sholat",
 	Long: `Salat adalah aplikasi command line untuk menampilkan jadwal sholat
 berdasarkan lokasi dan metode perhitungan yang dikonfigurasi.`,
+	// Errors are printed by Execute, on stderr and without the usage text
+	SilenceErrors: true,
+	SilenceUsage:  true,
+	// Suggest subcommands for typos, as cobra does for unknown commands
+	SuggestionsMinimumDistance: 2,
+	// Subcommands are matched first, so any argument left is an unknown command
+	Args: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return nil
+		}
+		msg := i18n.Sprintf("perintah tidak dikenal %q untuk %q", args[0], cmd.CommandPath())
+		if suggestions := cmd.SuggestionsFor(args[0]); len(suggestions) > 0 {
+			msg += "\n" + i18n.Sprintf("Mungkin maksud Anda: %s", strings.Join(suggestions, ", "))
+		}
+		return &usageError{i18n.Errorf("%v\nJalankan '%s --help' untuk melihat penggunaan.", msg, cmd.CommandPath())}
+	},
+	// Location overrides apply to whichever command runs
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if langFlag != "" {
+			if _, err := i18n.Parse(langFlag); err != nil {
+				return &usageError{err}
+			}
+		}
+		if !config.ValidClock(clockFlag) {
+			return &usageError{i18n.Errorf("format jam tidak valid %q, pilih 12h atau 24h", clockFlag)}
+		}
+		return bindOverrideFlags(cmd)
+	},
 	// Default command: show prayer times when no subcommand is provided
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		// Check if config exists
 		cfg, err := config.LoadConfig()
-		if err != nil || cfg.Latitude == 0 || cfg.Longitude == 0 {
+		if err != nil {
+			return loadError(err)
+		}
+		if !cfg.HasLocation() {
 			// If no config or incomplete config, run setup
-			fmt.Println("Konfigurasi belum lengkap. Menjalankan setup...")
-			setupCmd.Run(cmd, args)
-			return
+			if !canPrompt() {
+				return &configError{i18n.Errorf("konfigurasi lokasi belum ada")}
+			}
+			fmt.Println(i18n.T("Konfigurasi belum lengkap. Menjalankan setup..."))
+			return setupInteractive(setupOptions{})
 		}
 
 		// Otherwise show prayer times
-		showPrayerTimes(false, "light")
+		return showPrayerTimes(false)
 	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
+	// Flag errors are reported before the config file is read
+	i18n.Set(i18n.FromEnv())
+	for _, cmd := range rootCmd.Commands() {
+		usageArgs(cmd)
+	}
 	err := rootCmd.Execute()
 	if err != nil {
-		os.Exit(1)
+		printError(err)
+		os.Exit(exitCode(err))
+	}
+}
+
+// usageArgs reports invalid arguments of cmd and its subcommands, like
+// invalid flags, as usage errors
+func usageArgs(cmd *cobra.Command) {
+	if validate := cmd.Args; validate != nil {
+		cmd.Args = func(cmd *cobra.Command, args []string) error {
+			if err := validate(cmd, args); err != nil {
+				return &usageError{i18n.Errorf("%v\nJalankan '%s --help' untuk melihat penggunaan.", err, cmd.CommandPath())}
+			}
+			return nil
+		}
+	}
+	for _, sub := range cmd.Commands() {
+		usageArgs(sub)
 	}
 }
 
 func init() {
 	cobra.OnInitialize(initConfig)
+	rootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return &usageError{i18n.Errorf("%v\nJalankan '%s --help' untuk melihat penggunaan.", err, cmd.CommandPath())}
+	})
 
 	// Here you will define your flags and configuration settings.
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.config/salat/config.yaml)")
+	rootCmd.PersistentFlags().StringVar(&profileName, "profile", "", "Profil lokasi yang dipakai (lihat 'salat profile list')")
+	rootCmd.PersistentFlags().StringVar(&langFlag, "lang", "", "Bahasa output: id, en, ms, atau ar (default dari konfigurasi atau LANG)")
+	addOverrideFlags(rootCmd)
+	addDisplayFlags()
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -79,13 +144,21 @@ func initConfig() {
 		viper.SetConfigName("config")
 	}
 
-	viper.AutomaticEnv() // read in environment variables that match
-
-	// If a config file is found, read it in.
-	if err := viper.ReadInConfig(); err == nil {
-		// Config file found and successfully parsed
-	} else {
-		// Config file not found or error reading it
-		// This is normal on first run, so we don't need to show an error
+	config.BindEnv() // read in SALAT_ environment variables
+	config.SelectProfile(profileName)
+
+	// If a config file is found, read it in. A file that cannot be parsed is
+	// reported by the commands that load the configuration.
+	err := config.ReadInConfig()
+	i18n.Set(outputLanguage())
+	applyDisplay()
+	if err == nil {
+		// Config file found and successfully parsed, or none yet; upgrade older schemas
+		backup, err := config.MigrateConfigFile()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.Sprintf("Peringatan: %v", err))
+		} else if backup != "" {
+			fmt.Fprintln(os.Stderr, i18n.Sprintf("Konfigurasi diperbarui ke versi %d (cadangan: %s)", config.CurrentVersion, backup))
+		}
 	}
 }
@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"jadwalsalat/config"
+	"jadwalsalat/geocode"
+	"jadwalsalat/i18n"
 	"jadwalsalat/salat"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // setupCmd represents the setup command
@@ -22,32 +26,98 @@ Lokasi bisa berupa:
   - Koordinat: "-6.2,106.8" 
   - Alamat: "Monas Jakarta" atau "Jakarta, Indonesia"
 
-Jika tidak ada parameter lokasi, akan menggunakan mode interaktif.`,
+Jika API geocoding tidak bisa dihubungi, lokasi dicari di gazetteer offline
+(provinsi, kabupaten/kota, dan kota besar dunia). Gunakan --offline untuk
+langsung memakai gazetteer tanpa internet.
+
+Jika nama lokasi cocok dengan beberapa tempat (misalnya "Padang"), Anda akan
+diminta memilih salah satu. Gunakan --first untuk langsung memakai hasil teratas.
+
+Jika tidak ada parameter lokasi, akan menggunakan mode interaktif.
+
+Untuk script, Docker, dan provisioning, gunakan --timezone dan --method untuk
+menjawab pertanyaan lewat flag, --yes untuk menerima semua pilihan default,
+atau --non-interactive agar setup gagal dengan pesan jelas alih-alih bertanya.
+Jika input bukan terminal, setup otomatis berjalan non-interaktif.
+
+  salat setup "Jakarta" --yes
+  salat setup -- "-6.2,106.8" --timezone Asia/Jakarta --method Kemenag --non-interactive`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		opts, err := setupOptionsFromFlags(cmd)
+		if err != nil {
+			return err
+		}
 		if len(args) > 0 {
-			return setupWithLocation(cmd, args[0])
+			return setupWithLocation(cmd, args[0], opts)
 		}
-		return setupInteractive()
+		return setupInteractive(opts)
 	},
 }
 
+// setupOptions are the setup answers given on the command line
+type setupOptions struct {
+	timezone string
+	method   string
+	// yes accepts the default answer of every question
+	yes bool
+	// api is the geocoding provider for this setup's lookups, from --api or
+	// --offline; it is not saved
+	api string
+}
+
+// geocodingAPI returns the provider for the lookups of this setup: the one
+// given on the command line, otherwise the configured one
+func (o setupOptions) geocodingAPI() string {
+	if o.api != "" {
+		return o.api
+	}
+	if api := viper.GetString("geocoding_api"); api != "" {
+		return api
+	}
+	return "nominatim"
+}
+
 func init() {
 	rootCmd.AddCommand(setupCmd)
-	setupCmd.Flags().StringP("api", "a", "nominatim", "API geocoding yang digunakan (nominatim/photon)")
+	setupCmd.Flags().StringP("api", "a", "", "API geocoding untuk pencarian ini ("+strings.Join(geocode.Providers(), "/")+", default: geocoding_api atau nominatim)")
+	setupCmd.Flags().Bool("offline", false, "Gunakan gazetteer offline tanpa koneksi internet")
+	setupCmd.Flags().Bool("first", false, "Langsung pakai hasil pencarian lokasi teratas tanpa bertanya")
+	setupCmd.Flags().String("timezone", "", "Timezone (default: timezone lokasi)")
+	setupCmd.Flags().StringP("method", "m", "", "Metode perhitungan (default: metode yang lazim di negara lokasi)")
+	setupCmd.Flags().BoolP("yes", "y", false, "Terima semua pilihan default tanpa bertanya")
+	setupCmd.Flags().BoolVar(&nonInteractive, "non-interactive", false, "Jangan pernah bertanya; gagal jika ada pilihan yang tidak bisa ditentukan")
 }
 
-// setupWithLocation performs setup with a location argument
-func setupWithLocation(cmd *cobra.Command, locInput string) error {
-	// Get API type from flag
-	apiType, err := cmd.Flags().GetString("api")
-	if err != nil {
-		apiType = "nominatim" // fallback to default
+// setupOptionsFromFlags reads and validates the setup answer flags
+func setupOptionsFromFlags(cmd *cobra.Command) (setupOptions, error) {
+	var opts setupOptions
+	opts.timezone, _ = cmd.Flags().GetString("timezone")
+	opts.method, _ = cmd.Flags().GetString("method")
+	opts.yes, _ = cmd.Flags().GetBool("yes")
+	opts.api, _ = cmd.Flags().GetString("api")
+	if offline, _ := cmd.Flags().GetBool("offline"); offline {
+		opts.api = config.OfflineGeocoder
 	}
 
-	fmt.Printf("🌍 Mengatur lokasi: %s\n", locInput)
+	if opts.timezone != "" {
+		if _, err := time.LoadLocation(opts.timezone); err != nil {
+			return opts, &locationError{i18n.Errorf("timezone tidak valid: %s", opts.timezone)}
+		}
+	}
+	if opts.method != "" && !config.ValidMethod(opts.method) {
+		return opts, &usageError{i18n.Errorf("metode tidak valid: %s. Pilih salah satu dari: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto", opts.method)}
+	}
+	return opts, nil
+}
+
+// setupWithLocation performs setup with a location argument
+func setupWithLocation(cmd *cobra.Command, locInput string, opts setupOptions) error {
+	apiType := opts.geocodingAPI()
+	fmt.Println(i18n.Sprintf("🌍 Mengatur lokasi: %s", locInput))
 
 	var lat, lon float64
 	var locationName string
+	var err error
 
 	// 1. Cek apakah input matching "lat,lon"
 	parts := strings.Split(locInput, ",")
@@ -65,218 +135,232 @@ func setupWithLocation(cmd *cobra.Command, locInput string) error {
 			lon, err = strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
 		}
 		if err != nil {
-			return fmt.Errorf("koordinat tidak valid: %v", err)
+			return &locationError{i18n.Errorf("koordinat tidak valid: %v", err)}
+		}
+		if err := checkCoordinates(lat, lon); err != nil {
+			return err
 		}
 
-		fmt.Printf("📍 Koordinat: %.6f, %.6f\n", lat, lon)
+		fmt.Println(i18n.Sprintf("📍 Koordinat: %.6f, %.6f", lat, lon))
 
 		// Dapatkan nama lokasi dari reverse geocoding
-		fmt.Println("🔍 Mencari nama lokasi...")
+		fmt.Println(i18n.T("🔍 Mencari nama lokasi..."))
 		locationName, err = config.ReverseGeocode(apiType, lat, lon)
 		if err != nil {
-			fmt.Printf("Warning: Tidak dapat menentukan nama lokasi: %v\n", err)
-			locationName = fmt.Sprintf("Lokasi (%.6f, %.6f)", lat, lon)
+			fmt.Println(i18n.Sprintf("Peringatan: tidak dapat menentukan nama lokasi: %v", err))
+			locationName = i18n.Sprintf("Lokasi (%.6f, %.6f)", lat, lon)
 		} else {
-			fmt.Printf("📍 Lokasi ditemukan: %s\n", locationName)
+			fmt.Println(i18n.Sprintf("📍 Lokasi ditemukan: %s", locationName))
 		}
 	} else {
 		// 2. Forward‐geocode jika bukan koordinat
-		fmt.Println("🔍 Mencari koordinat lokasi...")
-		lat, lon, locationName, err = config.ForwardGeocode(apiType, locInput)
+		fmt.Println(i18n.T("🔍 Mencari koordinat lokasi..."))
+		first, _ := cmd.Flags().GetBool("first")
+		result, err := resolveLocation(apiType, locInput, first || opts.yes)
 		if err != nil {
-			return fmt.Errorf("error geocoding: %v", err)
+			return i18n.Errorf("gagal mencari lokasi: %w", err)
 		}
-		fmt.Printf("📍 Lokasi: %s\n", locationName)
-		fmt.Printf("📍 Koordinat: %.6f, %.6f\n", lat, lon)
+		lat, lon, locationName = result.Latitude, result.Longitude, result.Name
+		fmt.Println(i18n.Sprintf("📍 Lokasi: %s", locationName))
+		fmt.Println(i18n.Sprintf("📍 Koordinat: %.6f, %.6f", lat, lon))
 	}
 
-	// Setup timezone dan method dengan default values
-	loc, err := config.DetectTimezone()
+	// Timezone diambil dari lokasi, bukan dari komputer
+	timezone, err := locationTimezone(lat, lon)
 	if err != nil {
-		return fmt.Errorf("error mendeteksi timezone: %v", err)
-	}
-	timezone := loc.String()
-
-	// Ask untuk override timezone jika perlu
-	overrideTz := false
-	prompt := &survey.Confirm{
-		Message: fmt.Sprintf("Timezone terdeteksi: %s. Ubah?", timezone),
-		Default: false,
-	}
-	if err := survey.AskOne(prompt, &overrideTz); err != nil {
-		return fmt.Errorf("error reading timezone confirmation: %v", err)
+		return &locationError{i18n.Errorf("gagal mendeteksi timezone: %v", err)}
 	}
 
-	if overrideTz {
-		tzPrompt := &survey.Input{
-			Message: "Masukkan timezone (contoh: Asia/Jakarta):",
-			Default: "Asia/Jakarta",
-		}
-		if err := survey.AskOne(tzPrompt, &timezone); err != nil {
-			return fmt.Errorf("error reading timezone input: %v", err)
-		}
-	}
-
-	// Ask untuk method
-	methods := []string{
-		string(salat.MWL),
-		string(salat.ISNA),
-		string(salat.Egypt),
-		string(salat.Makkah),
-		string(salat.Karachi),
-		string(salat.Tehran),
-		string(salat.Kemenag),
-		string(salat.JAKIM),
-	}
-
-	method := string(salat.MWL)
-	methodPrompt := &survey.Select{
-		Message: "Pilih metode perhitungan waktu sholat:",
-		Options: methods,
-		Default: method,
+	timezone, err = chooseTimezone(opts, timezone, lat, lon)
+	if err != nil {
+		return err
 	}
-	if err := survey.AskOne(methodPrompt, &method); err != nil {
-		return fmt.Errorf("error reading method selection: %v", err)
+	method, err := chooseMethod(opts, lat, lon)
+	if err != nil {
+		return err
 	}
 
 	// Simpan konfigurasi
-	cfg := &config.Config{
-		Timezone:     timezone,
-		Latitude:     lat,
-		Longitude:    lon,
-		Method:       method,
-		LocationName: locationName,
-		GeocodingAPI: apiType,
+	if err := saveLocation(timezone, lat, lon, method, locationName); err != nil {
+		return i18n.Errorf("gagal menyimpan konfigurasi: %v", err)
 	}
 
-	err = config.SaveConfig(cfg)
-	if err != nil {
-		return fmt.Errorf("error menyimpan konfigurasi: %v", err)
-	}
-
-	fmt.Println("✅ Konfigurasi berhasil disimpan!")
-	fmt.Println("Jalankan 'salat show' untuk melihat jadwal sholat hari ini.")
+	fmt.Println(i18n.T("✅ Konfigurasi berhasil disimpan!"))
+	fmt.Println(i18n.T("Jalankan 'salat show' untuk melihat jadwal sholat hari ini."))
 	return nil
 }
 
 // setupInteractive runs the interactive setup process
-func setupInteractive() error {
-	fmt.Println("Selamat datang di setup salat CLI!")
-
-	// Detect timezone
-	loc, err := config.DetectTimezone()
-	if err != nil {
-		fmt.Printf("Error mendeteksi timezone: %v\n", err)
-		return err
-	}
-
-	fmt.Printf("Timezone terdeteksi: %s\n", loc.String())
-
-	// Ask if user wants to override timezone
-	overrideTz := false
-	prompt := &survey.Confirm{
-		Message: "Apakah Anda ingin mengubah timezone?",
-		Default: false,
-	}
-	if err := survey.AskOne(prompt, &overrideTz); err != nil {
-		return fmt.Errorf("error reading timezone confirmation: %v", err)
+func setupInteractive(opts setupOptions) error {
+	if !canPrompt() {
+		return &usageError{i18n.Errorf("lokasi wajib diberikan dalam mode non-interaktif, contoh: salat setup \"Jakarta\" --yes")}
 	}
 
-	timezone := loc.String()
-	if overrideTz {
-		tzPrompt := &survey.Input{
-			Message: "Masukkan timezone (contoh: Asia/Jakarta):",
-			Default: "Asia/Jakarta",
-		}
-		if err := survey.AskOne(tzPrompt, &timezone); err != nil {
-			return fmt.Errorf("error reading timezone input: %v", err)
-		}
-	}
+	fmt.Println(i18n.T("Selamat datang di setup salat CLI!"))
 
 	// Ask for latitude and longitude
 	latStr := "-6.2"
 	lonStr := "106.8"
 
 	latPrompt := &survey.Input{
-		Message: "Masukkan latitude lokasi Anda:",
+		Message: i18n.T("Masukkan latitude lokasi Anda:"),
 		Default: latStr,
 	}
 	if err := survey.AskOne(latPrompt, &latStr); err != nil {
-		return fmt.Errorf("error reading latitude input: %v", err)
+		return i18n.Errorf("gagal membaca input latitude: %v", err)
 	}
 
 	lonPrompt := &survey.Input{
-		Message: "Masukkan longitude lokasi Anda:",
+		Message: i18n.T("Masukkan longitude lokasi Anda:"),
 		Default: lonStr,
 	}
 	if err := survey.AskOne(lonPrompt, &lonStr); err != nil {
-		return fmt.Errorf("error reading longitude input: %v", err)
+		return i18n.Errorf("gagal membaca input longitude: %v", err)
 	}
 
 	// Parse latitude and longitude
 	lat, err := strconv.ParseFloat(strings.TrimSpace(latStr), 64)
 	if err != nil {
-		fmt.Printf("Error parsing latitude: %v\n", err)
-		return err
+		return &locationError{i18n.Errorf("latitude tidak valid: %v", err)}
 	}
 
 	lon, err := strconv.ParseFloat(strings.TrimSpace(lonStr), 64)
 	if err != nil {
-		fmt.Printf("Error parsing longitude: %v\n", err)
+		return &locationError{i18n.Errorf("longitude tidak valid: %v", err)}
+	}
+	if err := checkCoordinates(lat, lon); err != nil {
 		return err
 	}
 
-	// Ask for calculation method
-	methods := []string{
-		string(salat.MWL),
-		string(salat.ISNA),
-		string(salat.Egypt),
-		string(salat.Makkah),
-		string(salat.Karachi),
-		string(salat.Tehran),
-		string(salat.Kemenag),
-		string(salat.JAKIM),
+	// Detect timezone of the location
+	timezone, err := locationTimezone(lat, lon)
+	if err != nil {
+		return &locationError{i18n.Errorf("gagal mendeteksi timezone: %v", err)}
 	}
 
-	method := string(salat.MWL)
-	methodPrompt := &survey.Select{
-		Message: "Pilih metode perhitungan waktu sholat:",
-		Options: methods,
-		Default: method,
+	timezone, err = chooseTimezone(opts, timezone, lat, lon)
+	if err != nil {
+		return err
 	}
-	if err := survey.AskOne(methodPrompt, &method); err != nil {
-		return fmt.Errorf("error reading method selection: %v", err)
+	method, err := chooseMethod(opts, lat, lon)
+	if err != nil {
+		return err
 	}
 
 	// Try to get location name via reverse geocoding
 	locationName := ""
-	apiType := "nominatim"
-	fmt.Println("🔍 Mencari nama lokasi...")
-	if name, err := config.ReverseGeocode(apiType, lat, lon); err == nil {
+	fmt.Println(i18n.T("🔍 Mencari nama lokasi..."))
+	if name, err := config.ReverseGeocode(opts.geocodingAPI(), lat, lon); err == nil {
 		locationName = name
-		fmt.Printf("📍 Lokasi ditemukan: %s\n", locationName)
+		fmt.Println(i18n.Sprintf("📍 Lokasi ditemukan: %s", locationName))
 	} else {
-		fmt.Printf("Warning: Tidak dapat menentukan nama lokasi: %v\n", err)
-		locationName = fmt.Sprintf("Lokasi (%.6f, %.6f)", lat, lon)
+		fmt.Println(i18n.Sprintf("Peringatan: tidak dapat menentukan nama lokasi: %v", err))
+		locationName = i18n.Sprintf("Lokasi (%.6f, %.6f)", lat, lon)
 	}
 
 	// Save configuration
-	cfg := &config.Config{
-		Timezone:     timezone,
-		Latitude:     lat,
-		Longitude:    lon,
-		Method:       method,
-		LocationName: locationName,
-		GeocodingAPI: apiType,
+	err = saveLocation(timezone, lat, lon, method, locationName)
+	if err != nil {
+		return i18n.Errorf("gagal menyimpan konfigurasi: %v", err)
 	}
 
-	err = config.SaveConfig(cfg)
+	fmt.Println(i18n.T("Konfigurasi berhasil disimpan!"))
+	fmt.Println(i18n.T("Jalankan 'salat show' untuk melihat jadwal sholat hari ini."))
+	return nil
+}
+
+// saveLocation stores the location in the profile in effect, keeping the
+// rest of the configuration
+func saveLocation(timezone string, lat, lon float64, method, locationName string) error {
+	cfg, err := config.LoadConfigUnvalidated()
 	if err != nil {
-		fmt.Printf("Error menyimpan konfigurasi: %v\n", err)
 		return err
 	}
 
-	fmt.Println("Konfigurasi berhasil disimpan!")
-	fmt.Println("Jalankan 'salat show' untuk melihat jadwal sholat hari ini.")
+	cfg.Timezone = timezone
+	cfg.Latitude = lat
+	cfg.Longitude = lon
+	cfg.Method = method
+	cfg.LocationName = locationName
+	return config.SaveConfig(cfg)
+}
+
+// chooseTimezone returns the timezone from --timezone, or asks whether to
+// override the detected one. Without a terminal the detected zone is used.
+func chooseTimezone(opts setupOptions, detected string, lat, lon float64) (string, error) {
+	if opts.timezone != "" {
+		fmt.Println(i18n.Sprintf("🕐 Timezone: %s", opts.timezone))
+		warnTimezoneMismatch(opts.timezone, lat, lon)
+		return opts.timezone, nil
+	}
+	if opts.yes || !canPrompt() {
+		fmt.Println(i18n.Sprintf("🕐 Timezone: %s", detected))
+		return detected, nil
+	}
+
+	timezone := detected
+	overrideTz := false
+	prompt := &survey.Confirm{
+		Message: i18n.Sprintf("Timezone lokasi: %s. Ubah?", timezone),
+		Default: false,
+	}
+	if err := survey.AskOne(prompt, &overrideTz); err != nil {
+		return "", i18n.Errorf("gagal membaca konfirmasi timezone: %v", err)
+	}
+
+	if overrideTz {
+		tzPrompt := &survey.Input{
+			Message: i18n.T("Masukkan timezone (contoh: Asia/Jakarta):"),
+			Default: timezone,
+		}
+		// An invalid zone is asked again, as --timezone rejects it
+		if err := survey.AskOne(tzPrompt, &timezone, survey.WithValidator(validateTimezone)); err != nil {
+			return "", i18n.Errorf("gagal membaca input timezone: %v", err)
+		}
+		timezone = strings.TrimSpace(timezone)
+		warnTimezoneMismatch(timezone, lat, lon)
+	}
+	return timezone, nil
+}
+
+// validateTimezone is a survey validator accepting IANA timezone names
+func validateTimezone(ans interface{}) error {
+	name, _ := ans.(string)
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return i18n.Errorf("timezone wajib diisi")
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return i18n.Errorf("timezone tidak valid: %s", name)
+	}
 	return nil
 }
+
+// chooseMethod returns the method from --method, or asks for one with the
+// convention of the location's country as the default. Without a terminal
+// the default is used.
+func chooseMethod(opts setupOptions, lat, lon float64) (string, error) {
+	method := string(config.ResolveMethod(string(salat.Auto), lat, lon))
+	if opts.method != "" {
+		method = opts.method
+	}
+	if opts.method != "" || opts.yes || !canPrompt() {
+		fmt.Println(i18n.Sprintf("🧮 Metode: %s", method))
+		return method, nil
+	}
+
+	methods := make([]string, len(salat.Methods))
+	for i, m := range salat.Methods {
+		methods[i] = string(m)
+	}
+
+	methodPrompt := &survey.Select{
+		Message: i18n.T("Pilih metode perhitungan waktu sholat:"),
+		Options: methods,
+		Default: method,
+	}
+	if err := survey.AskOne(methodPrompt, &method); err != nil {
+		return "", i18n.Errorf("gagal membaca pilihan metode: %v", err)
+	}
+	return method, nil
+}
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"jadwalsalat/config"
+)
+
+// setSetupFlags sets flags of the setup command for one test
+func setSetupFlags(t *testing.T, flags map[string]string) {
+	t.Helper()
+	for name, value := range flags {
+		f := setupCmd.Flags().Lookup(name)
+		def := f.DefValue
+		if err := f.Value.Set(value); err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { f.Value.Set(def) })
+	}
+}
+
+func TestSetupOptionsFromFlags(t *testing.T) {
+	setSetupFlags(t, map[string]string{"timezone": "Asia/Pontianak", "method": "MWL", "yes": "true"})
+	opts, err := setupOptionsFromFlags(setupCmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if opts != (setupOptions{timezone: "Asia/Pontianak", method: "MWL", yes: true}) {
+		t.Errorf("options = %+v", opts)
+	}
+
+	// --offline takes precedence over --api for this setup's lookups
+	setSetupFlags(t, map[string]string{"api": "photon"})
+	if opts, _ := setupOptionsFromFlags(setupCmd); opts.geocodingAPI() != "photon" {
+		t.Errorf("--api photon = %q", opts.geocodingAPI())
+	}
+	setSetupFlags(t, map[string]string{"offline": "true"})
+	if opts, _ := setupOptionsFromFlags(setupCmd); opts.geocodingAPI() != config.OfflineGeocoder {
+		t.Errorf("--offline = %q", opts.geocodingAPI())
+	}
+	setSetupFlags(t, map[string]string{"api": "", "offline": "false"})
+
+	var locErr *locationError
+	setSetupFlags(t, map[string]string{"timezone": "Asia/Atlantis"})
+	if _, err := setupOptionsFromFlags(setupCmd); !errors.As(err, &locErr) {
+		t.Errorf("invalid --timezone = %v, want a location error", err)
+	}
+
+	var usage *usageError
+	setSetupFlags(t, map[string]string{"timezone": "", "method": "Kemenang"})
+	if _, err := setupOptionsFromFlags(setupCmd); !errors.As(err, &usage) {
+		t.Errorf("invalid --method = %v, want a usage error", err)
+	}
+}
+
+func TestSetupGeocodingAPI(t *testing.T) {
+	useConfig(t, "")
+	if got := (setupOptions{}).geocodingAPI(); got != "nominatim" {
+		t.Errorf("default = %q, want nominatim", got)
+	}
+	useConfig(t, "geocoding_api: photon\n")
+	if got := (setupOptions{}).geocodingAPI(); got != "photon" {
+		t.Errorf("configured = %q, want photon", got)
+	}
+	if got := (setupOptions{api: config.OfflineGeocoder}).geocodingAPI(); got != config.OfflineGeocoder {
+		t.Errorf("--offline = %q, want %s", got, config.OfflineGeocoder)
+	}
+}
+
+func TestSetupNonInteractive(t *testing.T) {
+	path := useConfig(t, "geocoding_api: photon\n")
+	offline := setupOptions{api: config.OfflineGeocoder}
+
+	// Without a location there is nothing to ask for
+	var usage *usageError
+	if err := setupInteractive(setupOptions{}); !errors.As(err, &usage) {
+		t.Errorf("setup without a location = %v, want a usage error", err)
+	}
+
+	// The timezone and method follow from the coordinates
+	if err := setupWithLocation(setupCmd, "-6.2,106.8", offline); err != nil {
+		t.Fatal(err)
+	}
+	v := readConfig(t, path)
+	if got := v.GetString("profiles.default.timezone"); got != "Asia/Jakarta" {
+		t.Errorf("timezone = %q, want Asia/Jakarta", got)
+	}
+	if got := v.GetString("profiles.default.method"); got != "Kemenag" {
+		t.Errorf("method = %q, want Kemenag for Indonesia", got)
+	}
+	// --offline is for this setup only
+	if got := v.GetString("geocoding_api"); got != "photon" {
+		t.Errorf("geocoding_api = %q, want photon kept", got)
+	}
+
+	// Flags answer the questions for a place name
+	if err := setupWithLocation(setupCmd, "Kabupaten Garut", setupOptions{timezone: "Asia/Pontianak", method: "MWL", api: config.OfflineGeocoder}); err != nil {
+		t.Fatal(err)
+	}
+	v = readConfig(t, path)
+	if got := v.GetString("profiles.default.timezone"); got != "Asia/Pontianak" {
+		t.Errorf("timezone = %q, want Asia/Pontianak from --timezone", got)
+	}
+	if got := v.GetString("profiles.default.method"); got != "MWL" {
+		t.Errorf("method = %q, want MWL from --method", got)
+	}
+	if got := v.GetFloat64("profiles.default.latitude"); got > -7 || got < -8 {
+		t.Errorf("latitude = %v, want Garut's", got)
+	}
+}
+
+func TestValidateTimezone(t *testing.T) {
+	for _, tz := range []string{"Asia/Jakarta", " Asia/Makassar ", "UTC"} {
+		if err := validateTimezone(tz); err != nil {
+			t.Errorf("validateTimezone(%q) = %v", tz, err)
+		}
+	}
+	for _, tz := range []string{"", "Asia/Jakrta", "WIB", "+07:00"} {
+		if err := validateTimezone(tz); err == nil {
+			t.Errorf("validateTimezone(%q) accepted", tz)
+		}
+	}
+}
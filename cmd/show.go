@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"jadwalsalat/config"
+	"jadwalsalat/i18n"
 	"jadwalsalat/salat"
+	"jadwalsalat/theme"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -18,159 +20,128 @@ var showCmd = &cobra.Command{
 	Aliases: []string{"s"},
 	Short:   "Tampilkan jadwal sholat hari ini",
 	Long:    `Tampilkan jadwal sholat hari ini berdasarkan konfigurasi lokasi dan metode perhitungan.`,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if value := formatFlag(cmd); value != "" {
+			return printFormat(value)
+		}
 		compactMode, _ := cmd.Flags().GetBool("compact")
-		theme, _ := cmd.Flags().GetString("theme")
-		showPrayerTimes(compactMode, theme)
+		return showPrayerTimes(compactMode)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(showCmd)
+	addOverrideFlags(showCmd)
+	addFormatFlag(showCmd)
 	showCmd.Flags().BoolP("compact", "c", false, "Tampilkan dalam mode compact")
-	showCmd.Flags().StringP("theme", "t", "light", "Pilih tema tampilan (light/dark)")
 }
 
 // showPrayerTimes displays the prayer times for today
-func showPrayerTimes(compactMode bool, theme string) {
+func showPrayerTimes(compactMode bool) error {
 	// Load configuration
-	cfg, err := config.LoadConfig()
+	cfg, loc, err := loadLocationConfig()
 	if err != nil {
-		fmt.Printf("Error loading configuration: %v\n", err)
-		fmt.Println("Run 'salat setup' to configure the application.")
-		return
-	}
-
-	// Parse timezone
-	loc, err := time.LoadLocation(cfg.Timezone)
-	if err != nil {
-		fmt.Printf("Error parsing timezone: %v\n", err)
-		return
+		return err
 	}
 
 	// Get current time in the configured timezone
 	now := time.Now().In(loc)
 
 	// Calculate prayer times for today
-	location := salat.Location{
-		Latitude:  cfg.Latitude,
-		Longitude: cfg.Longitude,
-		Method:    salat.CalculationMethod(cfg.Method),
-	}
+	location := cfg.SalatLocation()
 
 	times, err := salat.TimesForDate(now, location)
 	if err != nil {
-		fmt.Printf("Error calculating prayer times: %v\n", err)
-		return
+		return i18n.Errorf("gagal menghitung jadwal sholat: %w", err)
 	}
 
 	// Get current and next prayer time
-	currentName, _ := salat.GetCurrentPrayer(now, times)
-	nextName, nextTime := salat.GetNextPrayer(now, times)
-	remaining := nextTime.Sub(now)
-
-	// Format remaining time
-	hours := int(remaining.Hours())
-	minutes := int(remaining.Minutes()) % 60
-	remainStr := fmt.Sprintf("%dh %02dm", hours, minutes)
-	if hours == 0 {
-		remainStr = fmt.Sprintf("%dm", minutes)
-	}
+	current, hasActive := salat.GetCurrentPrayer(now, times)
+	next := salat.GetNextPrayer(now, times)
+	remaining := next.Time.Sub(now)
 
-	// Setup colors based on theme
-	var headerFgColor, nextFgColor color.Attribute
+	remainStr := i18n.Duration(remaining)
 
-	if theme == "dark" {
-		headerFgColor = color.FgHiCyan
-		nextFgColor = color.FgHiYellow
-	} else { // light theme (default)
-		headerFgColor = color.FgBlue
-		nextFgColor = color.FgYellow
+	if display.screenReader {
+		speakSchedule(cfg, now, times)
+		return nil
 	}
 
-	// Create color objects
-	headerColor := color.New(headerFgColor, color.Bold)
-	nextColor := color.New(nextFgColor)
+	colors, err := loadThemeColors(theme.ShowDefault)
+	if err != nil {
+		return err
+	}
 
 	// Print header (hanya sekali)
 	fmt.Print("\n")
-	headerColor.Printf("🕌 Jadwal Sholat - %s\n", now.Format("Monday, 02 January 2006"))
-	fmt.Printf("📍 %s (%.6f, %.6f) • %s\n\n", getLocationNameFromConfig(cfg), cfg.Latitude, cfg.Longitude, cfg.Method)
+	colors.header.Println(i18n.Sprintf("🕌 Jadwal Sholat - %s", i18n.Date(now)))
+	fmt.Printf("%s%s (%.6f, %.6f) • %s\n\n", icon("📍"), getLocationNameFromConfig(cfg), cfg.Latitude, cfg.Longitude, methodLabel(cfg))
 
 	// Compact mode - single line output
 	if compactMode {
 		var parts []string
-		prayerTimes := []struct {
-			name string
-			time time.Time
-		}{
-			{"Subuh", times.Subuh},
-			{"Dzuhur", times.Dzuhur},
-			{"Ashar", times.Ashar},
-			{"Maghrib", times.Maghrib},
-			{"Isya", times.Isya},
-		}
-
-		for _, prayer := range prayerTimes {
-			if prayer.name == currentName {
-				parts = append(parts, fmt.Sprintf("%s ►", prayer.name))
-			} else if prayer.time.Before(now) {
-				parts = append(parts, fmt.Sprintf("%s ✓", prayer.name))
-			} else if prayer.name == nextName {
-				parts = append(parts, fmt.Sprintf("%s %s", prayer.name, remainStr))
+		for _, prayer := range times.All() {
+			if !prayer.Prayer.HasAdzan() {
+				continue
+			}
+			label := prayer.Prayer.Name(i18n.Current())
+			if hasActive && prayer.Prayer == current.Prayer {
+				parts = append(parts, fmt.Sprintf("%s ►", label))
+			} else if prayer.Time.Before(now) {
+				parts = append(parts, fmt.Sprintf("%s ✓", label))
+			} else if next.DayOffset == 0 && prayer.Prayer == next.Prayer {
+				parts = append(parts, fmt.Sprintf("%s %s", label, remainStr))
 			} else {
-				parts = append(parts, prayer.name)
+				parts = append(parts, label)
 			}
 		}
 
 		fmt.Println(strings.Join(parts, " | "))
 		fmt.Println()
-		return
+		return nil
 	}
 
-	// Buat tabel sederhana menggunakan fmt.Printf
-	prayerTimes := []struct {
-		name string
-		time time.Time
-	}{
-		{"🌙  Imsak", times.Imsak},
-		{"🌅  Subuh", times.Subuh},
-		{"☀️  Dzuhur", times.Dzuhur},
-		{"🌤️  Ashar", times.Ashar},
-		{"🌇  Maghrib", times.Maghrib},
-		{"✨  Isya", times.Isya},
-	}
-
-	// Print header tabel
-	fmt.Printf("%-15s %-8s %-10s\n", "WAKTU", "JAM", "STATUS")
-	fmt.Println("-------------------------------")
+	// Print header tabel, with the time column as wide as the clock format
+	timeWidth := max(8, i18n.ClockWidth()+1)
+	separator := strings.Repeat("-", 23+timeWidth)
+	fmt.Printf("%-15s %-*s %-10s\n", i18n.T("WAKTU"), timeWidth, i18n.T("JAM"), i18n.T("STATUS"))
+	fmt.Println(separator)
 
 	// Print baris tabel
-	for _, prayer := range prayerTimes {
-		prayerName := strings.TrimSpace(strings.Split(prayer.name, " ")[1]) // Remove emoji
-		timeStr := prayer.time.Format("15:04")
+	for _, prayer := range times.All() {
+		label := prayerIcon(prayer.Prayer) + prayer.Prayer.Name(i18n.Current())
+		timeStr := i18n.Clock(prayer.Time)
 		var status string
+		var rowColor *color.Color
 
-		if prayerName == currentName {
-			status = "► AKTIF"
-		} else if prayer.time.Before(now) {
+		if hasActive && prayer.Prayer == current.Prayer {
+			status = "► " + i18n.T("AKTIF")
+			rowColor = colors.active
+		} else if prayer.Time.Before(now) {
 			status = "✓"
-		} else if prayerName == nextName {
+			rowColor = colors.passed
+		} else if next.DayOffset == 0 && prayer.Prayer == next.Prayer {
 			status = remainStr
+			rowColor = colors.next
 		} else {
 			status = ""
 		}
 
-		fmt.Printf("%-15s %-8s %-10s\n", prayer.name, timeStr, status)
+		row := fmt.Sprintf("%-15s %-*s %-10s", label, timeWidth, timeStr, status)
+		if rowColor != nil {
+			rowColor.Println(row)
+		} else {
+			fmt.Println(row)
+		}
 	}
 
-	fmt.Println("-------------------------------")
+	fmt.Println(separator)
 
 	// Print next prayer info
 	fmt.Println()
-	nextEmoji := salat.GetPrayerEmoji(strings.Split(nextName, " ")[0])
-	nextColor.Printf("⏰ Sholat berikutnya: %s %s dalam %s\n", nextEmoji, nextName, remainStr)
+	colors.next.Println(i18n.Sprintf("⏰ Sholat berikutnya: %s%s dalam %s", prayerIcon(next.Prayer), next.Label(i18n.Current()), remainStr))
 	fmt.Println()
+	return nil
 }
 
 // Helper function to get location name (placeholder - could be enhanced with geocoding)
@@ -185,5 +156,15 @@ func getLocationNameFromConfig(cfg *config.Config) string {
 		cfg.Longitude >= 106 && cfg.Longitude <= 107 {
 		return "Jakarta"
 	}
-	return "Lokasi Anda"
+	return i18n.T("Lokasi Anda")
+}
+
+// methodLabel returns the calculation method for display, showing what
+// "auto" resolved to
+func methodLabel(cfg *config.Config) string {
+	method := config.ResolveMethod(cfg.Method, cfg.Latitude, cfg.Longitude)
+	if string(method) != cfg.Method {
+		return fmt.Sprintf("%s (auto)", method)
+	}
+	return cfg.Method
 }
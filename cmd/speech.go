@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"jadwalsalat/config"
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+)
+
+// spokenDuration formats a duration in words for screen readers, e.g.
+// "2 jam 5 menit"
+func spokenDuration(d time.Duration) string {
+	minutes := int(d.Minutes())
+	switch {
+	case minutes < 1:
+		return i18n.T("kurang dari satu menit")
+	case minutes < 60:
+		return i18n.Sprintf("%d menit", minutes)
+	case minutes%60 == 0:
+		return i18n.Sprintf("%d jam", minutes/60)
+	default:
+		return i18n.Sprintf("%d jam %d menit", minutes/60, minutes%60)
+	}
+}
+
+// speakSchedule prints the day's prayer times as sentences for screen readers
+func speakSchedule(cfg *config.Config, now time.Time, times salat.PrayerTimes) {
+	fmt.Println(i18n.Sprintf("Jadwal sholat %s di %s, metode %s.", i18n.Date(now), getLocationNameFromConfig(cfg), methodLabel(cfg)))
+
+	current, hasActive := salat.GetCurrentPrayer(now, times)
+	next := salat.GetNextPrayer(now, times)
+	for _, p := range times.All() {
+		name := p.Prayer.Name(i18n.Current())
+		clock := i18n.Clock(p.Time)
+		switch {
+		case hasActive && p.Prayer == current.Prayer:
+			fmt.Println(i18n.Sprintf("%s pukul %s, sedang berlangsung.", name, clock))
+		case p.Time.Before(now):
+			fmt.Println(i18n.Sprintf("%s pukul %s, sudah lewat.", name, clock))
+		case next.DayOffset == 0 && p.Prayer == next.Prayer:
+			fmt.Println(i18n.Sprintf("%s pukul %s, %s lagi.", name, clock, spokenDuration(p.Time.Sub(now))))
+		default:
+			fmt.Println(i18n.Sprintf("%s pukul %s.", name, clock))
+		}
+	}
+	if next.DayOffset == 1 {
+		fmt.Println(i18n.Sprintf("Sholat berikutnya %s pukul %s, %s lagi.", next.Label(i18n.Current()), i18n.Clock(next.Time), spokenDuration(next.Time.Sub(now))))
+	}
+}
+
+// speakCountdown prints the current and next prayer, and how much of the
+// time between the previous prayer and the next has passed, as sentences
+// for screen readers
+func speakCountdown(cfg *config.Config, now time.Time, times salat.PrayerTimes) {
+	fmt.Println(i18n.Sprintf("Pukul %s di %s.", i18n.Clock(now), getLocationNameFromConfig(cfg)))
+
+	if current, ok := salat.GetCurrentPrayer(now, times); ok {
+		fmt.Println(i18n.Sprintf("Waktu sholat saat ini %s, dimulai pukul %s.", current.Prayer.Name(i18n.Current()), i18n.Clock(current.Time)))
+	} else {
+		fmt.Println(i18n.T("Saat ini bukan waktu sholat."))
+	}
+
+	next := salat.GetNextPrayer(now, times)
+	fmt.Println(i18n.Sprintf("Sholat berikutnya %s pukul %s, %s lagi.", next.Label(i18n.Current()), i18n.Clock(next.Time), spokenDuration(next.Time.Sub(now))))
+
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	from := i18n.T("tengah malam")
+	if prev, ok := salat.GetPreviousPrayer(next, times); ok {
+		start, from = prev.Time, prev.Prayer.Name(i18n.Current())
+	}
+	if interval := next.Time.Sub(start); interval > 0 {
+		percent := min(max(int(100*now.Sub(start)/interval), 0), 100)
+		fmt.Println(i18n.Sprintf("%d persen waktu dari %s sampai %s sudah berlalu.", percent, from, next.Label(i18n.Current())))
+	}
+}
+
+// watchSpoken is watch mode for screen readers: rather than redrawing the
+// screen every minute it prints the countdown once, then a sentence when a
+// prayer time arrives
+func watchSpoken(cfg *config.Config, loc *time.Location, notify bool) error {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	ticker := time.NewTicker(1 * time.Minute)
+	defer ticker.Stop()
+
+	var last salat.Prayer
+	for first := true; ; first = false {
+		now := time.Now().In(loc)
+		times, err := salat.TimesForDate(now, cfg.SalatLocation())
+		if err != nil {
+			return i18n.Errorf("gagal menghitung jadwal sholat: %w", err)
+		}
+
+		current, _ := salat.GetCurrentPrayer(now, times)
+		if first {
+			speakCountdown(cfg, now, times)
+		} else if current.Prayer != last && current.Prayer != 0 {
+			fmt.Println(i18n.Sprintf("Waktu %s telah tiba, pukul %s.", current.Prayer.Name(i18n.Current()), i18n.Clock(current.Time)))
+			if notify {
+				fmt.Print("\a")
+			}
+		}
+		last = current.Prayer
+
+		select {
+		case <-ticker.C:
+		case <-sigCh:
+			fmt.Println(i18n.T("Keluar dari mode watch..."))
+			return nil
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"jadwalsalat/format"
+	"jadwalsalat/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+// statusStyles are the output styles of the status command
+var statusStyles = []string{"plain", "tmux", "waybar", "polybar"}
+
+// defaultStatusFormat is the status text when --format is not given
+const defaultStatusFormat = "{{with .Next.Emoji}}{{.}} {{end}}{{.Next.Label}} {{.Next.Clock}} ({{.Next.In | short}})"
+
+// statusCmd represents the status command
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Satu baris status untuk tmux, Waybar, dan Polybar",
+	Long: `Cetak sholat berikutnya dalam satu baris untuk status bar. Jadwal hari ini
+disimpan di cache, jadi perintah ini cukup ringan untuk dijalankan setiap
+beberapa detik.
+
+Gaya:
+  plain    teks biasa
+  tmux     dengan warna tmux (#[fg=...])
+  waybar   JSON untuk modul custom Waybar dengan "return-type": "json"
+  polybar  dengan warna Polybar (%{F#...})
+
+Kurang dari --urgent menit sebelum waktu sholat, teks diberi warna merah dan
+Waybar mendapat class "urgent".
+
+Contoh penggunaan:
+  salat status
+  salat status --style tmux
+  salat status --style waybar --urgent 15
+  salat status --style polybar --format '{{.Next.Name}} {{.Next.In | short}}'`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		style, _ := cmd.Flags().GetString("style")
+		urgent, _ := cmd.Flags().GetInt("urgent")
+		return printStatus(strings.ToLower(style), formatFlag(cmd), time.Duration(urgent)*time.Minute)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+	addOverrideFlags(statusCmd)
+	addFormatFlag(statusCmd)
+	statusCmd.Flags().String("style", "plain", "Gaya output: "+strings.Join(statusStyles, ", "))
+	statusCmd.Flags().Int("urgent", 10, "Tandai mendesak sekian menit sebelum waktu sholat")
+}
+
+// waybarStatus is the JSON of a Waybar custom module
+type waybarStatus struct {
+	Text       string `json:"text"`
+	Alt        string `json:"alt"`
+	Tooltip    string `json:"tooltip"`
+	Class      string `json:"class"`
+	Percentage int    `json:"percentage"`
+}
+
+// printStatus prints the status line of the next prayer in the given style
+func printStatus(style, formatValue string, urgentBefore time.Duration) error {
+	if !slices.Contains(statusStyles, style) {
+		return &usageError{i18n.Errorf("gaya tidak dikenal %q, pilih salah satu dari: %s", style, strings.Join(statusStyles, ", "))}
+	}
+
+	cfg, loc, err := loadLocationConfig()
+	if err != nil {
+		return err
+	}
+	text := defaultStatusFormat
+	if formatValue != "" {
+		text = formatTemplate(cfg, formatValue)
+	}
+	out, data, err := renderFormat(cfg, text, time.Now().In(loc))
+	if err != nil {
+		return err
+	}
+	line := strings.TrimRight(out, "\n")
+	urgent := data.Next.In < urgentBefore
+
+	switch style {
+	case "tmux":
+		colour := "green"
+		if urgent {
+			colour = "red,bold"
+		}
+		line = "#[fg=" + colour + "]" + line + "#[default]"
+	case "polybar":
+		colour := "#98c379"
+		if urgent {
+			colour = "#e06c75"
+		}
+		line = "%{F" + colour + "}" + line + "%{F-}"
+	case "waybar":
+		class := "normal"
+		if urgent {
+			class = "urgent"
+		}
+		status, err := json.Marshal(waybarStatus{
+			Text:       line,
+			Alt:        data.Next.Key,
+			Tooltip:    statusTooltip(data),
+			Class:      class,
+			Percentage: int(data.Progress),
+		})
+		if err != nil {
+			return err
+		}
+		line = string(status)
+	}
+	fmt.Println(line)
+	return nil
+}
+
+// statusTooltip lists today's prayer times with the next one marked
+func statusTooltip(data *format.Data) string {
+	lines := []string{data.Location.Name, data.Date + " • " + data.Hijri.Date, ""}
+	for _, p := range data.Prayers {
+		marker := "  "
+		if !data.Next.Tomorrow && p.Key == data.Next.Key {
+			marker = "▶ "
+		}
+		if p.Emoji != "" {
+			marker += p.Emoji + " "
+		}
+		lines = append(lines, fmt.Sprintf("%s%s  %s", marker, pad(p.Name, 8), p.Clock))
+	}
+	return strings.Join(lines, "\n")
+}
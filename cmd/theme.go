@@ -0,0 +1,256 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"jadwalsalat/config"
+	"jadwalsalat/i18n"
+	"jadwalsalat/theme"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// themeCmd represents the theme command
+var themeCmd = &cobra.Command{
+	Use:   "theme",
+	Short: "Kelola tema warna",
+	Long: `Kelola tema warna untuk show, now, next, watch, world, dan tui.
+
+Tema bawaan: dark (default), light, high-contrast, dan mono. Tema sendiri
+ditulis di config.yaml atau diatur dengan 'salat config set', misalnya:
+  salat config set themes.senja.header "hi-magenta bold"
+  salat config set themes.senja.active "black on-yellow"
+
+Bagian yang tidak diatur diambil dari tema bawaan dengan nama yang sama,
+atau dari tema dark. Pakai tema sekali jalan dengan --theme.`,
+}
+
+// themeListCmd represents the theme list command
+var themeListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "Tampilkan daftar tema beserta contohnya",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return listThemes()
+	},
+}
+
+// themeUseCmd represents the theme use command
+var themeUseCmd = &cobra.Command{
+	Use:   "use [nama]",
+	Short: "Pakai tema untuk semua perintah",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setConfig("display.theme", args[0], false, false)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(themeCmd)
+	themeCmd.AddCommand(themeListCmd)
+	themeCmd.AddCommand(themeUseCmd)
+}
+
+// listThemes prints every theme with a sample of its styles, marking the
+// one in effect
+func listThemes() error {
+	cfg, err := config.LoadBaseConfig()
+	if err != nil {
+		return err
+	}
+	active := themeFlag
+	if active == "" {
+		active = cfg.Display.Theme
+	}
+	if active == "" {
+		active = theme.Default
+	}
+
+	for _, name := range theme.Names(cfg.Themes) {
+		t, _ := theme.Lookup(name, cfg.Themes)
+		marker := " "
+		if name == strings.ToLower(active) {
+			marker = "*"
+		}
+		fmt.Printf("%s %-14s ", marker, name)
+		styleColor(t.Header).Print(i18n.T("Judul"))
+		fmt.Print(" ")
+		styleColor(t.Active).Print(i18n.T("AKTIF"))
+		fmt.Print(" ")
+		styleColor(t.Next).Print(i18n.T("Berikutnya"))
+		fmt.Print(" ")
+		styleColor(t.Passed).Print(i18n.T("Lewat"))
+		fmt.Print(" ")
+		styleColor(t.Progress).Print("████")
+		styleColor(t.Passed).Print("░░")
+		fmt.Println()
+	}
+	return nil
+}
+
+// setThemeStyle sets the style of a role of a user theme from a key such as
+// "senja.header"
+func setThemeStyle(cfg *config.Config, key, value string) error {
+	name, role, ok := strings.Cut(key, ".")
+	if !ok {
+		return &usageError{i18n.Errorf("gunakan themes.<nama>.<bagian>, dengan bagian salah satu dari: %s", strings.Join(theme.Roles, ", "))}
+	}
+	if err := config.ValidateThemeName(name); err != nil {
+		return &usageError{err}
+	}
+	if _, err := theme.ParseStyle(value); err != nil {
+		return &usageError{err}
+	}
+	t := cfg.Themes[name]
+	if !t.Set(role, value) {
+		return &usageError{i18n.Errorf("gunakan themes.<nama>.<bagian>, dengan bagian salah satu dari: %s", strings.Join(theme.Roles, ", "))}
+	}
+	if cfg.Themes == nil {
+		cfg.Themes = map[string]theme.Theme{}
+	}
+	cfg.Themes[name] = t
+	fmt.Println(i18n.Sprintf("Tema %s: %s diatur ke %s, pakai dengan --theme %s", name, role, value, name))
+	return nil
+}
+
+// unsetThemeStyle removes a user theme, or the style of one of its roles,
+// from a key such as "senja" or "senja.header"
+func unsetThemeStyle(cfg *config.Config, key string) error {
+	name, role, hasRole := strings.Cut(key, ".")
+	t, ok := cfg.Themes[name]
+	if !ok {
+		return &usageError{i18n.Errorf("tidak ada tema dengan nama %q", name)}
+	}
+	if !hasRole {
+		delete(cfg.Themes, name)
+		return nil
+	}
+	if !t.Set(role, "") {
+		return &usageError{i18n.Errorf("gunakan themes.<nama>.<bagian>, dengan bagian salah satu dari: %s", strings.Join(theme.Roles, ", "))}
+	}
+	if t.IsZero() {
+		delete(cfg.Themes, name)
+	} else {
+		cfg.Themes[name] = t
+	}
+	return nil
+}
+
+// themeFlag is the theme chosen with --theme for this run
+var themeFlag string
+
+// themeColors are the styles of a theme for fatih/color output
+type themeColors struct {
+	header, active, next, passed, progress *color.Color
+}
+
+// currentTheme returns the theme chosen with --theme, display.theme (or
+// SALAT_DISPLAY_THEME), or fallback
+func currentTheme(fallback string) (theme.Theme, error) {
+	name := themeFlag
+	if name == "" {
+		name = viper.GetString("display.theme")
+	}
+	if name == "" {
+		name = fallback
+	}
+	var user map[string]theme.Theme
+	if err := viper.UnmarshalKey("themes", &user); err != nil {
+		return theme.Theme{}, &configError{i18n.Errorf("gagal membaca tema: %v", err)}
+	}
+	t, ok := theme.Lookup(strings.ToLower(name), user)
+	if !ok {
+		return theme.Theme{}, &usageError{i18n.Errorf("tema tidak dikenal %q, pilih salah satu dari: %s", name, strings.Join(theme.Names(user), ", "))}
+	}
+	return t, nil
+}
+
+// loadThemeColors returns the colours of the theme in effect, or of
+// fallback when none is chosen
+func loadThemeColors(fallback string) (themeColors, error) {
+	t, err := currentTheme(fallback)
+	if err != nil {
+		return themeColors{}, err
+	}
+	return themeColors{
+		header:   styleColor(t.Header),
+		active:   styleColor(t.Active),
+		next:     styleColor(t.Next),
+		passed:   styleColor(t.Passed),
+		progress: styleColor(t.Progress),
+	}, nil
+}
+
+// parseStyle parses a theme style; styles are validated with the
+// configuration, so an invalid one is simply left unstyled
+func parseStyle(s string) theme.Style {
+	style, err := theme.ParseStyle(s)
+	if err != nil {
+		return theme.Style{Fg: theme.NoColor, Bg: theme.NoColor}
+	}
+	return style
+}
+
+// styleColor converts a theme style to a fatih/color colour
+func styleColor(s string) *color.Color {
+	style := parseStyle(s)
+	var attrs []color.Attribute
+	if style.Fg != theme.NoColor {
+		attrs = append(attrs, colorAttributes(style.Fg, color.FgBlack, color.FgHiBlack, 38)...)
+	}
+	if style.Bg != theme.NoColor {
+		attrs = append(attrs, colorAttributes(style.Bg, color.BgBlack, color.BgHiBlack, 48)...)
+	}
+	for _, a := range []struct {
+		on   bool
+		attr color.Attribute
+	}{
+		{style.Bold, color.Bold},
+		{style.Faint, color.Faint},
+		{style.Italic, color.Italic},
+		{style.Underline, color.Underline},
+		{style.Reverse, color.ReverseVideo},
+	} {
+		if a.on {
+			attrs = append(attrs, a.attr)
+		}
+	}
+	return color.New(attrs...)
+}
+
+// colorAttributes returns the SGR attributes of a colour: the basic or
+// bright code for colours 0-15, and the 256-colour sequence otherwise
+func colorAttributes(c theme.Color, basic, bright, extended color.Attribute) []color.Attribute {
+	switch {
+	case c < 8:
+		return []color.Attribute{basic + color.Attribute(c)}
+	case c < 16:
+		return []color.Attribute{bright + color.Attribute(c-8)}
+	default:
+		return []color.Attribute{extended, 5, color.Attribute(c)}
+	}
+}
+
+// styleLipgloss converts a theme style to a lipgloss style
+func styleLipgloss(s string) lipgloss.Style {
+	style := parseStyle(s)
+	l := lipgloss.NewStyle().
+		Bold(style.Bold).
+		Faint(style.Faint).
+		Italic(style.Italic).
+		Underline(style.Underline).
+		Reverse(style.Reverse)
+	if style.Fg != theme.NoColor {
+		l = l.Foreground(lipgloss.Color(strconv.Itoa(int(style.Fg))))
+	}
+	if style.Bg != theme.NoColor {
+		l = l.Background(lipgloss.Color(strconv.Itoa(int(style.Bg))))
+	}
+	return l
+}
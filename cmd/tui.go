@@ -0,0 +1,437 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"jadwalsalat/config"
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+	"jadwalsalat/theme"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+// tuiCmd represents the tui command
+var tuiCmd = &cobra.Command{
+	Use:     "tui",
+	Aliases: []string{"dashboard"},
+	Short:   "Tampilkan dashboard jadwal sholat interaktif",
+	Long: `Tampilkan dashboard layar penuh dengan countdown per detik, garis waktu
+sehari, dan navigasi antar hari dan bulan.
+
+Tombol:
+  ←/→ atau h/l     hari sebelumnya/berikutnya
+  [/] atau PgUp/PgDn  bulan sebelumnya/berikutnya
+  t                kembali ke hari ini
+  v                ganti tampilan hari/bulan
+  m                ganti metode perhitungan (tidak disimpan)
+  q                keluar`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runTUI()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tuiCmd)
+	addOverrideFlags(tuiCmd)
+}
+
+// runTUI starts the dashboard in the alternate screen
+func runTUI() error {
+	// Screen readers cannot follow a redrawn full-screen view
+	if display.screenReader {
+		return showPrayerTimes(false)
+	}
+
+	if !isatty.IsTerminal(os.Stdout.Fd()) && !isatty.IsCygwinTerminal(os.Stdout.Fd()) {
+		return &usageError{i18n.Errorf("salat tui membutuhkan terminal, gunakan 'salat show' untuk output biasa")}
+	}
+
+	cfg, loc, err := loadLocationConfig()
+	if err != nil {
+		return err
+	}
+	t, err := currentTheme(theme.Default)
+	if err != nil {
+		return err
+	}
+	applyTUITheme(t)
+
+	m := newTUIModel(cfg, loc, time.Now())
+	if _, err := tea.NewProgram(m, tea.WithAltScreen()).Run(); err != nil {
+		return i18n.Errorf("gagal menjalankan dashboard: %v", err)
+	}
+	return nil
+}
+
+// tickMsg is sent every second to refresh the countdown
+type tickMsg time.Time
+
+// tuiModel is the state of the dashboard
+type tuiModel struct {
+	cfg *config.Config
+	loc *time.Location
+
+	// methods is the cycle of methods the m key switches between
+	methods []string
+
+	now  time.Time
+	date time.Time // selected day, at midnight
+
+	times    salat.PrayerTimes // prayer times of the selected day
+	timesErr error
+	today    salat.PrayerTimes // prayer times of today, for the countdown
+	todayErr error
+
+	monthView bool
+	width     int
+	height    int
+}
+
+// Styles of the dashboard
+var (
+	tuiTitleStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("14"))
+	tuiActiveStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("11"))
+	tuiNextStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	tuiPassedStyle  = lipgloss.NewStyle().Faint(true)
+	tuiSelectStyle  = lipgloss.NewStyle().Reverse(true)
+	tuiHelpStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	tuiErrorStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
+	tuiFilledStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("10"))
+	tuiMarkerStyle  = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("14"))
+	tuiCountdownBox = lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).Padding(0, 2).BorderForeground(lipgloss.Color("10"))
+)
+
+// applyTUITheme sets the dashboard styles from a theme
+func applyTUITheme(t theme.Theme) {
+	tuiTitleStyle = styleLipgloss(t.Header)
+	tuiMarkerStyle = styleLipgloss(t.Header)
+	tuiActiveStyle = styleLipgloss(t.Active)
+	tuiNextStyle = styleLipgloss(t.Next)
+	tuiPassedStyle = styleLipgloss(t.Passed)
+	tuiFilledStyle = styleLipgloss(t.Progress)
+	tuiCountdownBox = tuiCountdownBox.BorderForeground(tuiNextStyle.GetForeground())
+}
+
+// newTUIModel returns the dashboard showing today
+func newTUIModel(cfg *config.Config, loc *time.Location, now time.Time) tuiModel {
+	methods := []string{string(salat.Auto)}
+	for _, method := range salat.Methods {
+		methods = append(methods, string(method))
+	}
+
+	m := tuiModel{cfg: cfg, loc: loc, methods: methods, now: now.In(loc), width: 80, height: 24}
+	m.date = startOfDay(m.now)
+	m.calculate()
+	return m
+}
+
+// startOfDay returns midnight of the day of t, in t's location
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// calculate recomputes the prayer times of the selected day and of today
+func (m *tuiModel) calculate() {
+	location := m.cfg.SalatLocation()
+	m.times, m.timesErr = salat.TimesForDate(m.date, location)
+	m.today, m.todayErr = salat.TimesForDate(startOfDay(m.now), location)
+}
+
+// isToday reports whether the selected day is today
+func (m tuiModel) isToday() bool {
+	return m.date.Equal(startOfDay(m.now))
+}
+
+func (m tuiModel) Init() tea.Cmd {
+	return tuiTick()
+}
+
+// tuiTick ticks on every second of the clock
+func tuiTick() tea.Cmd {
+	return tea.Every(time.Second, func(t time.Time) tea.Msg {
+		return tickMsg(t)
+	})
+}
+
+func (m tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tickMsg:
+		previous := m.now
+		m.now = time.Time(msg).In(m.loc)
+		// Follow the clock across midnight when today is shown
+		if !startOfDay(previous).Equal(startOfDay(m.now)) {
+			if m.date.Equal(startOfDay(previous)) {
+				m.date = startOfDay(m.now)
+			}
+			m.calculate()
+		}
+		return m, tuiTick()
+
+	case tea.WindowSizeMsg:
+		m.width, m.height = msg.Width, msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		case "left", "h":
+			m.date = m.date.AddDate(0, 0, -1)
+		case "right", "l":
+			m.date = m.date.AddDate(0, 0, 1)
+		case "up", "k":
+			if m.monthView {
+				m.date = m.date.AddDate(0, 0, -1)
+			}
+		case "down", "j":
+			if m.monthView {
+				m.date = m.date.AddDate(0, 0, 1)
+			}
+		case "[", "pgup":
+			m.date = addMonths(m.date, -1)
+		case "]", "pgdown":
+			m.date = addMonths(m.date, 1)
+		case "t", "home":
+			m.date = startOfDay(m.now)
+		case "v", "tab":
+			m.monthView = !m.monthView
+		case "m":
+			m.cycleMethod()
+		default:
+			return m, nil
+		}
+		m.calculate()
+	}
+	return m, nil
+}
+
+// addMonths moves t by months, keeping the day within the target month
+func addMonths(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	day := min(t.Day(), daysIn(first))
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, t.Location())
+}
+
+// daysIn returns the number of days in the month of t
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+// cycleMethod switches to the next calculation method for this session
+func (m *tuiModel) cycleMethod() {
+	cfg := *m.cfg
+	next := 0
+	for i, method := range m.methods {
+		if strings.EqualFold(method, cfg.Method) {
+			next = (i + 1) % len(m.methods)
+		}
+	}
+	cfg.Method = m.methods[next]
+	m.cfg = &cfg
+}
+
+func (m tuiModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(tuiTitleStyle.Render(i18n.T("🕌 Jadwal Sholat")))
+	b.WriteString("  " + i18n.LiveClock(m.now) + "\n")
+	fmt.Fprintf(&b, "%s%s • %s\n", icon("📍"), getLocationNameFromConfig(m.cfg), methodLabel(m.cfg))
+	date := i18n.Date(m.date)
+	if m.isToday() {
+		date = i18n.Sprintf("%s (hari ini)", date)
+	}
+	b.WriteString(tuiTitleStyle.Render(date) + "\n\n")
+
+	if m.monthView {
+		b.WriteString(m.monthTable())
+	} else {
+		b.WriteString(m.dayView())
+	}
+
+	b.WriteString("\n" + m.help())
+	return b.String()
+}
+
+// dayView renders the prayer table, timeline, and countdown of the selected day
+func (m tuiModel) dayView() string {
+	var b strings.Builder
+	if m.timesErr != nil {
+		b.WriteString(tuiErrorStyle.Render(icon("⚠️")+m.timesErr.Error()) + "\n")
+		return b.String()
+	}
+
+	var current, next salat.Prayer
+	if m.isToday() {
+		if c, ok := salat.GetCurrentPrayer(m.now, m.times); ok {
+			current = c.Prayer
+		}
+		if n := salat.GetNextPrayer(m.now, m.times); n.DayOffset == 0 {
+			next = n.Prayer
+		}
+	}
+
+	for _, p := range m.times.All() {
+		line := fmt.Sprintf("%s%-8s %s", prayerIcon(p.Prayer), p.Prayer.Name(i18n.Current()), i18n.Clock(p.Time))
+		switch {
+		case p.Prayer == current:
+			b.WriteString(tuiActiveStyle.Render(line + "  ► " + i18n.T("AKTIF")))
+		case p.Prayer == next:
+			b.WriteString(tuiNextStyle.Render(line + "  " + icon("⏰") + i18n.LongDuration(p.Time.Sub(m.now))))
+		case m.isToday() && p.Time.Before(m.now), m.date.Before(startOfDay(m.now)):
+			b.WriteString(tuiPassedStyle.Render(line + "  ✓"))
+		default:
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n" + m.timeline() + "\n\n")
+	b.WriteString(m.countdown() + "\n")
+	return b.String()
+}
+
+// timeline renders the selected day from 00:00 to 24:00 with a marker at
+// every prayer and, for today, the part of the day already passed
+func (m tuiModel) timeline() string {
+	width := max(24, min(m.width-2, 120))
+	position := func(t time.Time) int {
+		p := int(t.Sub(m.date).Minutes() / (24 * 60) * float64(width))
+		return max(0, min(width-1, p))
+	}
+
+	// How much of the day has passed
+	filled := 0
+	switch {
+	case m.isToday():
+		filled = position(m.now) + 1
+	case m.date.Before(startOfDay(m.now)):
+		filled = width
+	}
+
+	markers := map[int]bool{}
+	for _, p := range m.times.All() {
+		if p.Prayer.HasAdzan() {
+			markers[position(p.Time)] = true
+		}
+	}
+
+	var bar strings.Builder
+	for i := 0; i < width; i++ {
+		switch {
+		case m.isToday() && i == filled-1:
+			bar.WriteString(tuiActiveStyle.Render("●"))
+		case markers[i]:
+			bar.WriteString(tuiMarkerStyle.Render("┃"))
+		case i < filled:
+			bar.WriteString(tuiFilledStyle.Render("━"))
+		default:
+			bar.WriteString(tuiPassedStyle.Render("─"))
+		}
+	}
+
+	// Prayer labels under their markers, shifted right when they would overlap
+	labels := []rune(strings.Repeat(" ", width+8))
+	next := 0
+	for _, p := range m.times.All() {
+		if !p.Prayer.HasAdzan() {
+			continue
+		}
+		// Arabic names all start with the article, so it is skipped
+		label := []rune(strings.TrimPrefix(p.Prayer.Name(i18n.Current()), "ال"))[:2]
+		at := max(position(p.Time), next)
+		if at+len(label) > len(labels) {
+			break
+		}
+		copy(labels[at:], label)
+		next = at + len(label) + 1
+	}
+
+	// Hour ticks every six hours
+	hours := []rune(strings.Repeat(" ", width+2))
+	for h := 0; h <= 24; h += 6 {
+		at := min(h*width/24, width-2)
+		copy(hours[at:], []rune(fmt.Sprintf("%02d", h)))
+	}
+
+	return bar.String() + "\n" + strings.TrimRight(string(labels), " ") + "\n" + tuiPassedStyle.Render(strings.TrimRight(string(hours), " "))
+}
+
+// countdown renders the time left until the next prayer from now
+func (m tuiModel) countdown() string {
+	if m.todayErr != nil {
+		return tuiErrorStyle.Render(icon("⚠️") + m.todayErr.Error())
+	}
+	next := salat.GetNextPrayer(m.now, m.today)
+	text := icon("⏱️") + i18n.Sprintf("%s%s pukul %s", prayerIcon(next.Prayer), next.Label(i18n.Current()), i18n.Clock(next.Time)) +
+		"\n   " + i18n.Sprintf("dalam %s", i18n.LongDuration(next.Time.Sub(m.now)))
+	return tuiCountdownBox.Render(text)
+}
+
+// monthTable renders the prayer times of every day in the selected month,
+// scrolled to keep the selected day visible
+func (m tuiModel) monthTable() string {
+	var b strings.Builder
+	// Columns are as wide as the translated prayer names or the times
+	header := pad(i18n.T("Tanggal"), 9)
+	var widths []int
+	for _, p := range salat.Prayers {
+		name := p.Name(i18n.Current())
+		widths = append(widths, max(i18n.ClockWidth()+1, utf8.RuneCountInString(name)))
+		header += " " + pad(name, widths[len(widths)-1])
+	}
+	b.WriteString(tuiTitleStyle.Render(header) + "\n")
+
+	days := daysIn(m.date)
+	// Leave room for the header above and the help below
+	rows := max(3, min(days, m.height-9))
+	first := max(1, min(m.date.Day()-rows/2, days-rows+1))
+
+	location := m.cfg.SalatLocation()
+	today := startOfDay(m.now)
+	for day := first; day < first+rows; day++ {
+		date := time.Date(m.date.Year(), m.date.Month(), day, 0, 0, 0, 0, m.loc)
+		label := fmt.Sprintf("%s %02d", i18n.ShortWeekday(date.Weekday()), date.Day())
+		if date.Equal(today) {
+			label += "•"
+		}
+
+		var line string
+		if times, err := salat.TimesForDate(date, location); err != nil {
+			line = pad(label, 9) + " " + i18n.T("tidak terdefinisi")
+		} else {
+			line = pad(label, 9)
+			for i, p := range times.All() {
+				line += " " + pad(i18n.Clock(p.Time), widths[i])
+			}
+		}
+
+		switch {
+		case day == m.date.Day():
+			b.WriteString(tuiSelectStyle.Render(line))
+		case date.Before(today):
+			b.WriteString(tuiPassedStyle.Render(line))
+		default:
+			b.WriteString(line)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// help renders the key bindings, shortened to fit narrow terminals
+func (m tuiModel) help() string {
+	help := i18n.T("←/→ hari • [/] bulan • t hari ini • v tampilan hari/bulan • m metode • q keluar")
+	if lipgloss.Width(help) > m.width {
+		help = i18n.T("←→ hari • [] bulan • t • v • m • q")
+	}
+	return tuiHelpStyle.Render(help)
+}
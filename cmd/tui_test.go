@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAddMonths(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		from   time.Time
+		months int
+		want   time.Time
+	}{
+		{date(2024, 3, 12), 1, date(2024, 4, 12)},
+		{date(2024, 1, 31), 1, date(2024, 2, 29)},
+		{date(2023, 1, 31), 1, date(2023, 2, 28)},
+		{date(2024, 3, 31), -1, date(2024, 2, 29)},
+		{date(2024, 12, 15), 1, date(2025, 1, 15)},
+		{date(2024, 1, 15), -1, date(2023, 12, 15)},
+		{date(2024, 5, 31), 12, date(2025, 5, 31)},
+	}
+	for _, tt := range tests {
+		if got := addMonths(tt.from, tt.months); !got.Equal(tt.want) {
+			t.Errorf("addMonths(%s, %d) = %s, want %s", tt.from.Format(time.DateOnly), tt.months, got.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+	}
+}
+
+func TestDaysIn(t *testing.T) {
+	tests := []struct {
+		year  int
+		month time.Month
+		want  int
+	}{
+		{2024, time.January, 31},
+		{2024, time.February, 29},
+		{2023, time.February, 28},
+		{1900, time.February, 28},
+		{2000, time.February, 29},
+		{2024, time.April, 30},
+		{2024, time.December, 31},
+	}
+	for _, tt := range tests {
+		if got := daysIn(time.Date(tt.year, tt.month, 15, 0, 0, 0, 0, time.UTC)); got != tt.want {
+			t.Errorf("daysIn(%d-%02d) = %d, want %d", tt.year, tt.month, got, tt.want)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"syscall/js"
 	"time"
 
+	"jadwalsalat/i18n"
 	"jadwalsalat/salat"
 )
 
@@ -64,22 +65,18 @@ func (api *SalatAPI) ProcessPrayerTime(this js.Value, args []js.Value) interface
 	}
 
 	// Get current prayer
-	currentPrayer, _ := salat.GetCurrentPrayer(now, times)
-	nextPrayerName, nextPrayerTime := salat.GetNextPrayer(now, times)
+	currentPrayer, hasCurrent := salat.GetCurrentPrayer(now, times)
+	nextPrayer := salat.GetNextPrayer(now, times)
 
 	// Ensure all values are basic types
-	currentPrayerStr := currentPrayer
-	if currentPrayerStr == "" {
-		currentPrayerStr = "Unknown"
-	}
-
-	nextPrayerStr := nextPrayerName
-	if nextPrayerStr == "" {
-		nextPrayerStr = "Unknown"
+	currentPrayerStr := "Unknown"
+	if hasCurrent {
+		currentPrayerStr = currentPrayer.Prayer.String()
 	}
+	nextPrayerStr := nextPrayer.Label(i18n.Default)
 
 	// Build result as JSON string
-	result := fmt.Sprintf(`{"location":{"latitude":%f,"longitude":%f},"method":"%s","date":"%s","prayers":{"imsak":"%s","subuh":"%s","dzuhur":"%s","ashar":"%s","maghrib":"%s","isya":"%s"},"current":{"prayer":"%s","emoji":"%s"},"next":{"prayer":"%s","time":"%s","emoji":"%s"},"timestamp":"%s"}`,
+	result := fmt.Sprintf(`{"location":{"latitude":%f,"longitude":%f},"method":"%s","date":"%s","prayers":{"imsak":"%s","subuh":"%s","dzuhur":"%s","ashar":"%s","maghrib":"%s","isya":"%s"},"current":{"prayer":"%s","emoji":"%s"},"next":{"prayer":"%s","time":"%s","emoji":"%s","dayOffset":%d},"timestamp":"%s"}`,
 		lat, lng,
 		string(method),
 		now.Format("2006-01-02"),
@@ -90,10 +87,11 @@ func (api *SalatAPI) ProcessPrayerTime(this js.Value, args []js.Value) interface
 		times.Maghrib.Format("15:04"),
 		times.Isya.Format("15:04"),
 		currentPrayerStr,
-		salat.GetPrayerEmoji(currentPrayerStr),
+		currentPrayer.Prayer.Emoji(),
 		nextPrayerStr,
-		nextPrayerTime.Format("15:04"),
-		salat.GetPrayerEmoji(nextPrayerStr),
+		nextPrayer.Time.Format("15:04"),
+		nextPrayer.Prayer.Emoji(),
+		nextPrayer.DayOffset,
 		now.Format(time.RFC3339),
 	)
 
@@ -8,8 +8,9 @@ import (
 	"syscall"
 	"time"
 
-	"jadwalsalat/config"
+	"jadwalsalat/i18n"
 	"jadwalsalat/salat"
+	"jadwalsalat/theme"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -21,51 +22,45 @@ var watchCmd = &cobra.Command{
 	Aliases: []string{"w"},
 	Short:   "Tampilkan jadwal sholat secara live",
 	Long:    `Tampilkan jadwal sholat secara live dengan update setiap menit.`,
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
 		notify, _ := cmd.Flags().GetBool("notify")
-		watchPrayerTimes(notify)
+		return watchPrayerTimes(notify)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(watchCmd)
+	addOverrideFlags(watchCmd)
 	watchCmd.Flags().BoolP("notify", "n", false, "Aktifkan notifikasi saat waktu sholat tiba")
 }
 
 // watchPrayerTimes displays prayer times in a live updating view
-func watchPrayerTimes(notify bool) {
+func watchPrayerTimes(notify bool) error {
 	// Load configuration
-	cfg, err := config.LoadConfig()
+	cfg, loc, err := loadLocationConfig()
 	if err != nil {
-		fmt.Printf("Error loading configuration: %v\n", err)
-		fmt.Println("Run 'salat setup' to configure the application.")
-		return
+		return err
 	}
 
-	// Parse timezone
-	loc, err := time.LoadLocation(cfg.Timezone)
-	if err != nil {
-		fmt.Printf("Error parsing timezone: %v\n", err)
-		return
+	if display.screenReader {
+		return watchSpoken(cfg, loc, notify)
 	}
 
 	// Setup signal handling for graceful exit
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
 
-	// Setup colors
-	headerColor := color.New(color.FgHiCyan, color.Bold)
-	activeColor := color.New(color.FgHiYellow, color.Bold)
-	nextColor := color.New(color.FgHiGreen)
-	normalColor := color.New(color.FgWhite)
-	timeColor := color.New(color.FgHiWhite)
+	colors, err := loadThemeColors(theme.Default)
+	if err != nil {
+		return err
+	}
 
 	// Create ticker for updates
 	ticker := time.NewTicker(1 * time.Minute)
 	defer ticker.Stop()
 
 	// Track the current prayer to detect changes
-	var lastCurrentPrayer string
+	var lastCurrentPrayer salat.Prayer
 
 	// Main loop
 	for {
@@ -73,47 +68,35 @@ func watchPrayerTimes(notify bool) {
 		now := time.Now().In(loc)
 
 		// Calculate prayer times for today
-		location := salat.Location{
-			Latitude:  cfg.Latitude,
-			Longitude: cfg.Longitude,
-			Method:    salat.CalculationMethod(cfg.Method),
-		}
+		location := cfg.SalatLocation()
 
 		times, err := salat.TimesForDate(now, location)
 		if err != nil {
-			fmt.Printf("Error calculating prayer times: %v\n", err)
-			return
+			return i18n.Errorf("gagal menghitung jadwal sholat: %w", err)
 		}
 
 		// Get current and next prayer time
-		currentName, _ := salat.GetCurrentPrayer(now, times)
-		nextName, nextTime := salat.GetNextPrayer(now, times)
-		remaining := nextTime.Sub(now)
-
-		// Format remaining time
-		hours := int(remaining.Hours())
-		minutes := int(remaining.Minutes()) % 60
-		seconds := int(remaining.Seconds()) % 60
-		remainStr := fmt.Sprintf("%dh %02dm %02ds", hours, minutes, seconds)
-		if hours == 0 {
-			remainStr = fmt.Sprintf("%dm %02ds", minutes, seconds)
-		}
+		current, _ := salat.GetCurrentPrayer(now, times)
+		next := salat.GetNextPrayer(now, times)
+		remaining := next.Time.Sub(now)
+
+		remainStr := i18n.LongDuration(remaining)
 
 		// Clear screen
 		fmt.Print("\033[H\033[2J")
 
 		// Print header
-		headerColor.Printf("🕌 JADWAL SHOLAT LIVE\n")
-		fmt.Printf("📍 %s (%.6f, %.6f) • %s\n", getLocationNameFromConfig(cfg), cfg.Latitude, cfg.Longitude, cfg.Method)
-		fmt.Printf("⏰ %s\n\n", now.Format("Monday, 02 January 2006 15:04:05"))
+		colors.header.Println(i18n.T("🕌 JADWAL SHOLAT LIVE"))
+		fmt.Printf("%s%s (%.6f, %.6f) • %s\n", icon("📍"), getLocationNameFromConfig(cfg), cfg.Latitude, cfg.Longitude, methodLabel(cfg))
+		fmt.Printf("%s%s %s\n\n", icon("⏰"), i18n.Date(now), i18n.LiveClock(now))
 
 		// Print prayer times
-		fmt.Println("Waktu Sholat Hari Ini:")
+		fmt.Println(i18n.T("Waktu Sholat Hari Ini:"))
 		fmt.Println("---------------------")
 
 		// Check if the current prayer has changed
-		prayerChanged := lastCurrentPrayer != currentName && lastCurrentPrayer != ""
-		lastCurrentPrayer = currentName
+		prayerChanged := lastCurrentPrayer != current.Prayer && lastCurrentPrayer != 0
+		lastCurrentPrayer = current.Prayer
 
 		// Send notification if enabled and prayer time has changed
 		if notify && prayerChanged {
@@ -121,7 +104,7 @@ func watchPrayerTimes(notify bool) {
 			notifyColor := color.New(color.FgHiWhite, color.BgHiRed)
 			fmt.Println()
 			notifyColor.Println("┌─────────────────────────────────────┐")
-			notifyColor.Printf("│ 🔔 WAKTU SHOLAT %s TELAH TIBA! │\n", strings.ToUpper(currentName))
+			notifyColor.Println(i18n.Sprintf("│ 🔔 WAKTU SHOLAT %s TELAH TIBA! │", strings.ToUpper(current.Prayer.Name(i18n.Current()))))
 			notifyColor.Println("└─────────────────────────────────────┘")
 			fmt.Println()
 
@@ -130,69 +113,44 @@ func watchPrayerTimes(notify bool) {
 		}
 
 		// Display prayer times with status
-		prayerTimes := []struct {
-			name string
-			time time.Time
-		}{
-			{"Subuh", times.Subuh},
-			{"Dzuhur", times.Dzuhur},
-			{"Ashar", times.Ashar},
-			{"Maghrib", times.Maghrib},
-			{"Isya", times.Isya},
-		}
-
-		for _, prayer := range prayerTimes {
-			emoji := salat.GetPrayerEmoji(prayer.name)
-			timeStr := prayer.time.Format("15:04")
-
-			if prayer.name == currentName {
-				activeColor.Printf("%s %s: %s ► AKTIF\n", emoji, prayer.name, timeStr)
-			} else if prayer.time.Before(now) {
-				normalColor.Printf("%s %s: %s ✓\n", emoji, prayer.name, timeStr)
-			} else if prayer.name == nextName {
-				nextColor.Printf("%s %s: %s ⏰ %s\n", emoji, prayer.name, timeStr, remainStr)
+		for _, prayer := range times.All() {
+			if !prayer.Prayer.HasAdzan() {
+				continue
+			}
+			emoji := prayerIcon(prayer.Prayer)
+			label := prayer.Prayer.Name(i18n.Current())
+			timeStr := i18n.Clock(prayer.Time)
+
+			if prayer.Prayer == current.Prayer {
+				colors.active.Printf("%s%s: %s ► %s\n", emoji, label, timeStr, i18n.T("AKTIF"))
+			} else if prayer.Time.Before(now) {
+				colors.passed.Printf("%s%s: %s ✓\n", emoji, label, timeStr)
+			} else if next.DayOffset == 0 && prayer.Prayer == next.Prayer {
+				colors.next.Printf("%s%s: %s %s%s\n", emoji, label, timeStr, icon("⏰"), remainStr)
 			} else {
-				timeColor.Printf("%s %s: %s\n", emoji, prayer.name, timeStr)
+				fmt.Printf("%s%s: %s\n", emoji, label, timeStr)
 			}
 		}
 
 		fmt.Println("\n---------------------")
 
 		// Display next prayer countdown
-		nextEmoji := salat.GetPrayerEmoji(strings.Split(nextName, " ")[0]) // Ambil nama sholat tanpa "(besok)"
-		nextColor.Printf("⏱️ Sholat berikutnya: %s %s dalam %s\n", nextEmoji, nextName, remainStr)
+		colors.next.Println(i18n.Sprintf("⏱️ Sholat berikutnya: %s%s dalam %s", prayerIcon(next.Prayer), next.Label(i18n.Current()), remainStr))
 
 		// Create ASCII progress bar
 		progressBarWidth := 40
 		var intervalDuration time.Duration
 
 		// Determine the interval (time between previous prayer and next prayer)
-		prayerTimesWithNext := []struct {
-			name string
-			time time.Time
-		}{
-			{"Subuh", times.Subuh},
-			{"Dzuhur", times.Dzuhur},
-			{"Ashar", times.Ashar},
-			{"Maghrib", times.Maghrib},
-			{"Isya", times.Isya},
-			{"Subuh (besok)", times.Subuh.Add(24 * time.Hour)},
-		}
-
 		var prevPrayer time.Time
-		for i, prayer := range prayerTimesWithNext {
-			if prayer.name == nextName {
-				if i == 0 {
-					// If it's the first prayer of the day, use midnight as the previous time
-					prevPrayer = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
-				} else {
-					prevPrayer = prayerTimesWithNext[i-1].time
-				}
-				break
-			}
+		if prev, ok := salat.GetPreviousPrayer(next, times); ok {
+			prevPrayer = prev.Time
+		} else {
+			// If it's the first prayer of the day, use midnight as the previous time
+			prevPrayer = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
 		}
 
-		intervalDuration = nextTime.Sub(prevPrayer)
+		intervalDuration = next.Time.Sub(prevPrayer)
 		elapsed := now.Sub(prevPrayer)
 
 		var progress float64
@@ -213,22 +171,18 @@ func watchPrayerTimes(notify bool) {
 		emptyWidth := progressBarWidth - filledWidth
 
 		// Print enhanced progress bar with percentage
-		percentColor := color.New(color.FgHiCyan)
-		percentColor.Printf("%.1f%% selesai\n", progress*100)
+		colors.progress.Println(i18n.Sprintf("%.1f%% selesai", progress*100))
 
 		// Print progress bar
-		progressColor := color.New(color.FgHiGreen)
-		emptyColor := color.New(color.FgHiBlack)
-
 		fmt.Print("[")
-		progressColor.Print(strings.Repeat("█", filledWidth))
-		emptyColor.Print(strings.Repeat("░", emptyWidth))
+		colors.progress.Print(strings.Repeat("█", filledWidth))
+		colors.passed.Print(strings.Repeat("░", emptyWidth))
 		fmt.Print("]")
 		fmt.Println()
 
 		// Print time markers
-		prevTimeStr := prevPrayer.Format("15:04")
-		nextTimeStr := nextTime.Format("15:04")
+		prevTimeStr := i18n.Clock(prevPrayer)
+		nextTimeStr := i18n.Clock(next.Time)
 		timeMarkerFmt := fmt.Sprintf("%%-%ds%%s\n", progressBarWidth+1)
 		fmt.Printf(timeMarkerFmt, prevTimeStr, nextTimeStr)
 
@@ -237,8 +191,8 @@ func watchPrayerTimes(notify bool) {
 		case <-ticker.C:
 			// Continue to next iteration
 		case <-sigCh:
-			fmt.Println("\nExiting watch mode...")
-			return
+			fmt.Println("\n" + i18n.T("Keluar dari mode watch..."))
+			return nil
 		}
 	}
 }
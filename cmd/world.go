@@ -0,0 +1,322 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"jadwalsalat/config"
+	"jadwalsalat/geocode"
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+	"jadwalsalat/theme"
+
+	"github.com/spf13/cobra"
+)
+
+// locationsFile is the default locations file inside the config directory
+const locationsFile = "locations.txt"
+
+// worldCmd represents the world command
+var worldCmd = &cobra.Command{
+	Use:   "world",
+	Short: "Bandingkan jadwal sholat beberapa lokasi",
+	Long: `Tampilkan jadwal sholat hari ini untuk beberapa lokasi berdampingan, masing-masing
+dalam timezone setempat, lengkap dengan waktu sholat saat ini dan berikutnya.
+
+Lokasi bisa berupa nama profil, alamat, atau koordinat, diambil dari:
+  1. flag --location (boleh diulang)
+  2. file lokasi (--file, default ~/.config/salat/locations.txt)
+  3. semua profil yang tersimpan
+
+File lokasi berisi satu lokasi per baris, dengan label opsional sebelum "=":
+
+  # keluarga
+  Makkah = Makkah, Saudi Arabia
+  Kairo = Cairo, Egypt
+  kantor
+
+Contoh penggunaan:
+  salat world -l Makkah -l Kairo -l Jakarta
+  salat world -l "Ummi = Cairo, Egypt" -l rumah
+  salat world --file keluarga.txt --local`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		locations, _ := cmd.Flags().GetStringArray("location")
+		file, _ := cmd.Flags().GetString("file")
+		method, _ := cmd.Flags().GetString("method")
+		offline, _ := cmd.Flags().GetBool("offline")
+		local, _ := cmd.Flags().GetBool("local")
+		return showWorld(locations, file, method, offline, local)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(worldCmd)
+	worldCmd.Flags().StringArrayP("location", "l", nil, "Lokasi (nama profil, alamat, atau koordinat), boleh diulang")
+	worldCmd.Flags().StringP("file", "f", "", "File daftar lokasi (default ~/.config/salat/locations.txt)")
+	worldCmd.Flags().StringP("method", "m", string(salat.Auto), "Metode perhitungan untuk lokasi yang bukan profil")
+	worldCmd.Flags().Bool("offline", false, "Cari lokasi di gazetteer offline tanpa internet")
+	worldCmd.Flags().Bool("local", false, "Tampilkan semua waktu dalam timezone konfigurasi")
+}
+
+// worldLocation is one column of the world table
+type worldLocation struct {
+	label   string
+	profile config.Profile
+}
+
+// showWorld prints the prayer times of several locations side by side
+func showWorld(entries []string, file, method string, offline, local bool) error {
+	if !config.ValidMethod(method) {
+		return &usageError{i18n.Errorf("metode tidak valid: %s", method)}
+	}
+
+	cfg, err := config.LoadBaseConfig()
+	if err != nil {
+		return err
+	}
+
+	if len(entries) == 0 {
+		if entries, err = readLocationsFile(file); err != nil {
+			return err
+		}
+	}
+	if len(entries) == 0 {
+		entries = cfg.ProfileNames()
+	}
+	if len(entries) == 0 {
+		return &configError{i18n.Errorf("tidak ada lokasi: gunakan --location, buat file %s, atau tambahkan profil dengan 'salat profile add'", locationsFile)}
+	}
+
+	apiType := cfg.GeocodingAPI
+	if apiType == "" {
+		apiType = "nominatim"
+	}
+	if offline {
+		apiType = config.OfflineGeocoder
+	}
+
+	var locations []worldLocation
+	for _, entry := range entries {
+		wl, err := resolveWorldLocation(cfg, apiType, entry, method)
+		if err != nil {
+			return err
+		}
+		locations = append(locations, wl)
+	}
+
+	// With --local every time is shown in the timezone of the profile in effect
+	var display *time.Location
+	if local {
+		if _, display, err = loadLocationConfig(); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	// Build the table one column per location
+	rowLabels := []string{"", i18n.T("Jam setempat")}
+	for _, p := range salat.Prayers {
+		rowLabels = append(rowLabels, p.Name(i18n.Current()))
+	}
+	rowLabels = append(rowLabels, i18n.T("Saat ini"), i18n.T("Berikutnya"), i18n.T("Dalam"))
+	columns := make([][]string, len(locations))
+	currents := make([]salat.Prayer, len(locations))
+	for i, wl := range locations {
+		loc, err := time.LoadLocation(wl.profile.Timezone)
+		if err != nil {
+			return &locationError{i18n.Errorf("timezone tidak valid %s: %v", wl.profile.Timezone, err)}
+		}
+		localNow := now.In(loc)
+		times, err := salat.TimesForDate(localNow, wl.profile.SalatLocation())
+		if err != nil {
+			return i18n.Errorf("gagal menghitung jadwal sholat untuk %s: %w", wl.label, err)
+		}
+
+		show := loc
+		if display != nil {
+			show = display
+		}
+
+		current, ok := salat.GetCurrentPrayer(localNow, times)
+		next := salat.GetNextPrayer(localNow, times)
+		currents[i] = current.Prayer
+
+		column := []string{wl.label, i18n.Clock(localNow) + " " + utcOffset(localNow)}
+		for _, p := range times.All() {
+			column = append(column, i18n.Clock(p.Time.In(show)))
+		}
+		currentLabel := "-"
+		if ok {
+			currentLabel = current.Prayer.Name(i18n.Current())
+		}
+		column = append(column, currentLabel, next.Label(i18n.Current())+" "+i18n.Clock(next.Time.In(show)), i18n.Duration(next.Time.Sub(now)))
+		columns[i] = column
+	}
+
+	// Size every column to its widest cell
+	labelWidth := 0
+	for _, l := range rowLabels {
+		labelWidth = max(labelWidth, utf8.RuneCountInString(l))
+	}
+	widths := make([]int, len(columns))
+	for i, column := range columns {
+		for _, cell := range column {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	colors, err := loadThemeColors(theme.Default)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+	colors.header.Println(i18n.T("🌍 Jadwal Sholat Dunia"))
+	if display != nil {
+		fmt.Println(i18n.Sprintf("Semua waktu dalam %s (%s)", display.String(), utcOffset(now.In(display))))
+	}
+	fmt.Println()
+
+	for row, label := range rowLabels {
+		fmt.Print(pad(label, labelWidth))
+		for i, column := range columns {
+			cell := pad(column[row], widths[i])
+			fmt.Print("  ")
+			switch {
+			case row == 0:
+				colors.header.Print(cell)
+			case row >= 2 && row < 2+len(salat.Prayers) && salat.Prayers[row-2] == currents[i]:
+				colors.active.Print(cell)
+			default:
+				fmt.Print(cell)
+			}
+		}
+		fmt.Println()
+		if row == 1 || row == 1+len(salat.Prayers) {
+			fmt.Println(strings.Repeat("-", labelWidth+sum(widths)+2*len(widths)))
+		}
+	}
+	fmt.Println()
+	return nil
+}
+
+// resolveWorldLocation turns a "[label =] location" entry into a column.
+// Profile names use the saved profile; anything else is geocoded.
+func resolveWorldLocation(cfg *config.Config, apiType, entry, method string) (worldLocation, error) {
+	label, query := "", strings.TrimSpace(entry)
+	if before, after, ok := strings.Cut(entry, "="); ok {
+		label, query = strings.TrimSpace(before), strings.TrimSpace(after)
+	}
+
+	if _, ok := cfg.Profiles[query]; ok || (query == config.DefaultProfile && cfg.Latitude != 0) {
+		p := *cfg
+		if err := p.UseProfile(query); err != nil {
+			return worldLocation{}, err
+		}
+		if label == "" {
+			label = query
+		}
+		return worldLocation{label: label, profile: p.CurrentProfile()}, nil
+	}
+
+	var result geocode.Result
+	if lat, lon, ok := parseCoordinates(query); ok {
+		result = geocode.Result{Latitude: lat, Longitude: lon, Name: i18n.Sprintf("Lokasi (%.4f, %.4f)", lat, lon)}
+		if label == "" {
+			label = fmt.Sprintf("%.2f, %.2f", lat, lon)
+		}
+	} else {
+		results, err := config.SearchLocations(apiType, query, 1)
+		if err != nil {
+			return worldLocation{}, i18n.Errorf("tidak dapat menemukan lokasi %q: %w", query, lookupError(err))
+		}
+		result = results[0]
+	}
+
+	timezone, err := geocode.Timezone(result.Latitude, result.Longitude)
+	if err != nil {
+		return worldLocation{}, &locationError{i18n.Errorf("gagal mendeteksi timezone untuk %q: %v", query, err)}
+	}
+	if label == "" {
+		label = strings.TrimSpace(strings.Split(result.Name, ",")[0])
+	}
+
+	return worldLocation{
+		label: label,
+		profile: config.Profile{
+			Timezone:     timezone,
+			Latitude:     result.Latitude,
+			Longitude:    result.Longitude,
+			Method:       method,
+			LocationName: result.Name,
+		},
+	}, nil
+}
+
+// readLocationsFile reads the entries of a locations file. A missing default
+// file is not an error.
+func readLocationsFile(path string) ([]string, error) {
+	explicit := path != ""
+	if !explicit {
+		configDir, err := config.GetConfigDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(configDir, locationsFile)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) && !explicit {
+			return nil, nil
+		}
+		return nil, i18n.Errorf("gagal membaca file lokasi: %v", err)
+	}
+	defer f.Close()
+
+	var entries []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, i18n.Errorf("gagal membaca file lokasi: %v", err)
+	}
+	return entries, nil
+}
+
+// utcOffset formats the UTC offset of t, e.g. "UTC+7" or "UTC+5:30"
+func utcOffset(t time.Time) string {
+	_, offset := t.Zone()
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	if offset%3600 != 0 {
+		return fmt.Sprintf("UTC%s%d:%02d", sign, offset/3600, offset%3600/60)
+	}
+	return fmt.Sprintf("UTC%s%d", sign, offset/3600)
+}
+
+// pad right-pads s with spaces to width characters
+func pad(s string, width int) string {
+	return s + strings.Repeat(" ", max(0, width-utf8.RuneCountInString(s)))
+}
+
+func sum(values []int) int {
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
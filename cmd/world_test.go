@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"jadwalsalat/config"
+)
+
+func TestResolveWorldLocation(t *testing.T) {
+	useConfig(t, "")
+	cfg := &config.Config{Profiles: map[string]config.Profile{
+		"kampung": {Timezone: "Asia/Jakarta", Latitude: -7.2, Longitude: 107.9, Method: "Kemenag", LocationName: "Garut"},
+	}}
+	tests := []struct {
+		entry, label, timezone string
+		lat                    float64
+	}{
+		{"kampung", "kampung", "Asia/Jakarta", -7.2},
+		{"Ibu = kampung", "Ibu", "Asia/Jakarta", -7.2},
+		{"21.4225,39.8262", "21.42, 39.83", "Asia/Riyadh", 21.4225},
+		{" Makkah = 21.4225, 39.8262 ", "Makkah", "Asia/Riyadh", 21.4225},
+		{"Kairo = Cairo, Egypt", "Kairo", "Africa/Cairo", 30.0},
+	}
+	for _, tt := range tests {
+		loc, err := resolveWorldLocation(cfg, config.OfflineGeocoder, tt.entry, "auto")
+		if err != nil {
+			t.Errorf("%q: %v", tt.entry, err)
+			continue
+		}
+		if loc.label != tt.label || loc.profile.Timezone != tt.timezone || int(loc.profile.Latitude) != int(tt.lat) {
+			t.Errorf("%q = %q %+v, want %q in %s at %v", tt.entry, loc.label, loc.profile, tt.label, tt.timezone, tt.lat)
+		}
+	}
+
+	if _, err := resolveWorldLocation(cfg, config.OfflineGeocoder, "Xyzzyplugh", "auto"); exitCode(err) != ExitInvalidLocation {
+		t.Errorf("unknown place = %v (exit %d), want an invalid location", err, exitCode(err))
+	}
+}
+
+func TestReadLocationsFile(t *testing.T) {
+	useConfig(t, "")
+
+	// A missing default file lists nothing, a missing given one is an error
+	if entries, err := readLocationsFile(""); err != nil || entries != nil {
+		t.Errorf("missing default file = %v, %v", entries, err)
+	}
+	if _, err := readLocationsFile(filepath.Join(t.TempDir(), "tidak-ada.txt")); err == nil {
+		t.Error("missing --file succeeded")
+	}
+
+	path := filepath.Join(t.TempDir(), "keluarga.txt")
+	content := "# keluarga\nMakkah = Makkah, Saudi Arabia\n\n  kantor  \n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := readLocationsFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Makkah = Makkah, Saudi Arabia", "kantor"}; !slices.Equal(entries, want) {
+		t.Errorf("entries = %q, want %q", entries, want)
+	}
+}
+
+func TestUTCOffset(t *testing.T) {
+	tests := []struct {
+		offset int
+		want   string
+	}{
+		{7 * 3600, "UTC+7"},
+		{0, "UTC+0"},
+		{-5 * 3600, "UTC-5"},
+		{5*3600 + 1800, "UTC+5:30"},
+		{-(3*3600 + 1800), "UTC-3:30"},
+		{5*3600 + 45*60, "UTC+5:45"},
+	}
+	for _, tt := range tests {
+		at := time.Date(2024, 3, 12, 12, 0, 0, 0, time.FixedZone("", tt.offset))
+		if got := utcOffset(at); got != tt.want {
+			t.Errorf("utcOffset(%d) = %q, want %q", tt.offset, got, tt.want)
+		}
+	}
+}
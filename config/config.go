@@ -1,258 +1,269 @@
 package config
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"net/url"
 	"os"
 	"path/filepath"
-	"strconv"
+	"reflect"
+	"sort"
 	"time"
 
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+	"jadwalsalat/theme"
+
 	"github.com/spf13/viper"
 )
 
 // Config holds all configuration for the application
 type Config struct {
-	Timezone     string  `mapstructure:"timezone"`
-	Latitude     float64 `mapstructure:"latitude"`
-	Longitude    float64 `mapstructure:"longitude"`
-	Method       string  `mapstructure:"method"`
-	LocationName string  `mapstructure:"location_name"`
-	GeocodingAPI string  `mapstructure:"geocoding_api"`
+	// Version is the schema version of the config file, see Migrate
+	Version        int     `mapstructure:"version"`
+	Timezone       string  `mapstructure:"timezone"`
+	Latitude       float64 `mapstructure:"latitude"`
+	Longitude      float64 `mapstructure:"longitude"`
+	Method         string  `mapstructure:"method"`
+	LocationName   string  `mapstructure:"location_name"`
+	GeocodingAPI   string  `mapstructure:"geocoding_api"`
+	GeocodingURL   string  `mapstructure:"geocoding_url"`
+	GeocodingEmail string  `mapstructure:"geocoding_email"`
+	// Language is the language of the output, see i18n.Parse; empty follows the locale
+	Language string `mapstructure:"language"`
+	// GeocodingCacheTTL is how long geocoding results are cached, e.g. "720h"; "0" disables the cache
+	GeocodingCacheTTL string            `mapstructure:"geocoding_cache_ttl"`
+	Adjustments       salat.Adjustments `mapstructure:"adjustments"`
+	SMTP              SMTPConfig        `mapstructure:"smtp"`
+	Digest            DigestConfig      `mapstructure:"digest"`
+	Display           DisplayConfig     `mapstructure:"display"`
+	// Formats are named --format templates
+	Formats map[string]string `mapstructure:"formats"`
+	// Themes are user-defined colour themes, see theme.Lookup
+	Themes map[string]theme.Theme `mapstructure:"themes"`
+	// ActiveProfile is the profile used when --profile is not given
+	ActiveProfile string             `mapstructure:"active_profile"`
+	Profiles      map[string]Profile `mapstructure:"profiles"`
+	// Profile is the profile whose location is in effect, empty when there
+	// is none yet
+	Profile string `mapstructure:"-"`
+	// overridden is set when flags or the environment changed the location
+	overridden bool
 }
 
-// GetConfigDir returns the directory where config is stored
-func GetConfigDir() (string, error) {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return "", err
-	}
-
-	configDir := filepath.Join(home, ".config", "salat")
-	return configDir, nil
+// SMTPConfig holds the mail server settings used by the digest command
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
 }
 
-// LoadConfig reads the configuration from disk
-func LoadConfig() (*Config, error) {
-	var config Config
+// DigestConfig holds the recipients of the schedule digest email
+type DigestConfig struct {
+	Recipients []string `mapstructure:"recipients"`
+}
 
-	err := viper.Unmarshal(&config)
-	if err != nil {
-		return nil, fmt.Errorf("unable to decode config into struct: %v", err)
-	}
+// DisplayConfig holds the accessibility preferences of the terminal output
+type DisplayConfig struct {
+	// NoColor turns off colors, like the NO_COLOR environment variable
+	NoColor bool `mapstructure:"no_color"`
+	NoEmoji bool `mapstructure:"no_emoji"`
+	// ScreenReader prints sentences instead of tables, symbols, and progress
+	// bars, and implies NoColor and NoEmoji
+	ScreenReader bool `mapstructure:"screen_reader"`
+	// Theme is the name of the colour theme; empty is theme.Default
+	Theme string `mapstructure:"theme"`
+	// Clock is "12h" or "24h"; empty is 24h
+	Clock string `mapstructure:"clock"`
+	// Seconds shows the seconds of prayer times
+	Seconds bool `mapstructure:"seconds"`
+	// Pasaran adds the Javanese pasaran to dates, e.g. "Senin Wage"
+	Pasaran bool `mapstructure:"pasaran"`
+}
 
-	return &config, nil
+// ValidClock reports whether clock is a value of display.clock
+func ValidClock(clock string) bool {
+	return clock == "" || clock == "12h" || clock == "24h"
 }
 
-// SaveConfig writes the configuration to disk
-func SaveConfig(config *Config) error {
-	viper.Set("timezone", config.Timezone)
-	viper.Set("latitude", config.Latitude)
-	viper.Set("longitude", config.Longitude)
-	viper.Set("method", config.Method)
-	viper.Set("location_name", config.LocationName)
-	viper.Set("geocoding_api", config.GeocodingAPI)
+// FormatNames returns the names of the named templates, sorted
+func (c *Config) FormatNames() []string {
+	names := make([]string, 0, len(c.Formats))
+	for name := range c.Formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
 
-	configDir, err := GetConfigDir()
-	if err != nil {
-		return err
+// ValidateFormatName checks that name can be used as a template name
+func ValidateFormatName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return i18n.Errorf("nama template tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'", name)
 	}
+	return nil
+}
 
-	if err := os.MkdirAll(configDir, 0755); err != nil {
-		return err
+// GetConfigDir returns the directory where config is stored
+func GetConfigDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
 	}
 
-	configPath := filepath.Join(configDir, "config.yaml")
-	return viper.WriteConfigAs(configPath)
+	configDir := filepath.Join(home, ".config", "salat")
+	return configDir, nil
 }
 
-// DetectTimezone attempts to detect the system timezone
-func DetectTimezone() (*time.Location, error) {
-	// Get local timezone
-	return time.LoadLocation("")
+// selectedProfile is the profile chosen for this run with --profile
+var selectedProfile string
+
+// SelectProfile chooses the profile LoadConfig uses instead of the active one
+func SelectProfile(name string) {
+	selectedProfile = name
 }
 
-// ForwardGeocode converts an address to coordinates using the specified API
-func ForwardGeocode(apiType, query string) (float64, float64, string, error) {
-	var apiURL string
-	switch apiType {
-	case "photon":
-		apiURL = fmt.Sprintf("https://photon.komoot.io/api/?q=%s&limit=1", url.QueryEscape(query))
-	default: // nominatim
-		apiURL = fmt.Sprintf("https://nominatim.openstreetmap.org/search?q=%s&format=json&limit=1",
-			url.QueryEscape(query))
+// SelectedProfile returns the profile in effect: the one chosen for this run,
+// otherwise the active profile
+func (c *Config) SelectedProfile() string {
+	if selectedProfile != "" {
+		return selectedProfile
 	}
+	return c.ActiveProfile
+}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
+// LoadConfig reads the configuration from disk, with the location of the
+// selected profile in effect and the overrides of this run applied. Invalid
+// values are reported as a *ValidationError.
+func LoadConfig() (*Config, error) {
+	config, err := LoadConfigUnvalidated()
 	if err != nil {
-		return 0, 0, "", err
+		return nil, err
 	}
-
-	if apiType != "photon" {
-		req.Header.Set("User-Agent", "jadwalsalat/1.0")
+	config.overridden = config.applyOverrides()
+	if err := config.Validate(); err != nil {
+		return nil, err
 	}
+	return config, nil
+}
 
-	resp, err := http.DefaultClient.Do(req)
+// LoadConfigUnvalidated is LoadConfig without validation and overrides, for
+// commands that repair, change, or inspect the stored configuration
+func LoadConfigUnvalidated() (*Config, error) {
+	config, err := LoadBaseConfig()
 	if err != nil {
-		return 0, 0, "", err
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	// Check HTTP status code
-	if resp.StatusCode != http.StatusOK {
-		return 0, 0, "", fmt.Errorf("geocoding API returned status %d", resp.StatusCode)
+	// The --profile flag takes precedence over the saved active profile
+	if err := config.UseProfile(config.SelectedProfile()); err != nil {
+		return nil, err
 	}
 
-	if apiType == "photon" {
-		var r struct {
-			Features []struct {
-				Geometry struct {
-					Coordinates []float64 `json:"coordinates"`
-				} `json:"geometry"`
-				Properties struct {
-					Name        string `json:"name"`
-					City        string `json:"city"`
-					State       string `json:"state"`
-					Country     string `json:"country"`
-					DisplayName string `json:"display_name"`
-				} `json:"properties"`
-			} `json:"features"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
-			return 0, 0, "", err
-		}
-		if len(r.Features) > 0 {
-			// Photon: [lon, lat]
-			lat := r.Features[0].Geometry.Coordinates[1]
-			lon := r.Features[0].Geometry.Coordinates[0]
-			props := r.Features[0].Properties
+	return config, nil
+}
 
-			// Build location name from available properties
-			locationName := props.Name
-			if props.City != "" {
-				locationName = props.City
-			}
-			if props.State != "" && locationName != "" {
-				locationName += ", " + props.State
-			}
-			if props.Country != "" && locationName != "" {
-				locationName += ", " + props.Country
-			}
-			if locationName == "" {
-				locationName = props.DisplayName
-			}
+// LoadBaseConfig reads the configuration from disk without any profile in
+// effect. A config file that cannot be parsed, or values that cannot be
+// decoded, are reported as a *FileError.
+func LoadBaseConfig() (*Config, error) {
+	if readErr != nil {
+		return nil, readErr
+	}
 
-			return lat, lon, locationName, nil
-		}
-	} else {
-		var r []struct {
-			Lat         string `json:"lat"`
-			Lon         string `json:"lon"`
-			DisplayName string `json:"display_name"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
-			return 0, 0, "", err
-		}
-		if len(r) > 0 {
-			lat, err := strconv.ParseFloat(r[0].Lat, 64)
-			if err != nil {
-				return 0, 0, "", fmt.Errorf("invalid latitude from API: %v", err)
-			}
-			lon, err := strconv.ParseFloat(r[0].Lon, 64)
-			if err != nil {
-				return 0, 0, "", fmt.Errorf("invalid longitude from API: %v", err)
-			}
-			return lat, lon, r[0].DisplayName, nil
-		}
+	var config Config
+	if err := viper.Unmarshal(&config); err != nil {
+		return nil, &FileError{Err: err}
 	}
 
-	return 0, 0, "", fmt.Errorf("no results found for %q", query)
+	return &config, nil
 }
 
-// ReverseGeocode converts coordinates to a location name
-func ReverseGeocode(apiType string, lat, lon float64) (string, error) {
-	var apiURL string
-	switch apiType {
-	case "photon":
-		apiURL = fmt.Sprintf("https://photon.komoot.io/reverse?lat=%f&lon=%f", lat, lon)
-	default: // nominatim
-		apiURL = fmt.Sprintf("https://nominatim.openstreetmap.org/reverse?lat=%f&lon=%f&format=json",
-			lat, lon)
+// SaveConfig writes the configuration to disk
+func SaveConfig(config *Config) error {
+	// Location settings belong to the profile in effect, the default profile
+	// when none is
+	if config.Profile == "" && config.HasLocation() {
+		config.Profile = DefaultProfile
 	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-	defer cancel()
-	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
-	if err != nil {
-		return "", err
+	if config.Profile != "" {
+		if config.Profiles == nil {
+			config.Profiles = map[string]Profile{}
+		}
+		config.Profiles[config.Profile] = config.CurrentProfile()
 	}
+	config.Version = CurrentVersion
 
-	if apiType != "photon" {
-		req.Header.Set("User-Agent", "jadwalsalat/1.0")
+	// The values in effect before the changes, to tell the ones made by the
+	// caller from those given by SALAT_ environment variables
+	loaded, err := LoadBaseConfig()
+	if err != nil {
+		return err
 	}
 
-	resp, err := http.DefaultClient.Do(req)
+	// Start from the file itself: viper also holds values from the
+	// environment, and keeps every key it has read, so removed profiles,
+	// formats, and themes would otherwise be written back
+	path, err := ConfigPath()
 	if err != nil {
-		return "", err
+		return err
 	}
-	defer resp.Body.Close()
-
-	// Check HTTP status code
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("geocoding API returned status %d", resp.StatusCode)
+	settings, err := ReadSettings(path)
+	if err != nil {
+		if !IsNotExist(err) {
+			return err
+		}
+		settings = map[string]interface{}{}
 	}
 
-	if apiType == "photon" {
-		var r struct {
-			Features []struct {
-				Properties struct {
-					Name        string `json:"name"`
-					City        string `json:"city"`
-					State       string `json:"state"`
-					Country     string `json:"country"`
-					DisplayName string `json:"display_name"`
-				} `json:"properties"`
-			} `json:"features"`
+	// A key set from the environment is only written when the caller changed
+	// it, so the file keeps its own value
+	set := func(key string, value, was interface{}) {
+		if !envSet(key) || !reflect.DeepEqual(value, was) {
+			setKey(settings, key, value)
 		}
-		if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
-			return "", err
-		}
-		if len(r.Features) > 0 {
-			props := r.Features[0].Properties
-
-			// Build location name from available properties
-			locationName := props.Name
-			if props.City != "" {
-				locationName = props.City
-			}
-			if props.State != "" && locationName != "" {
-				locationName += ", " + props.State
-			}
-			if props.Country != "" && locationName != "" {
-				locationName += ", " + props.Country
-			}
-			if locationName == "" {
-				locationName = props.DisplayName
-			}
+		viper.Set(key, value)
+	}
+	set("version", config.Version, config.Version)
+	set("active_profile", config.ActiveProfile, loaded.ActiveProfile)
+	set("language", config.Language, loaded.Language)
+	set("geocoding_api", config.GeocodingAPI, loaded.GeocodingAPI)
+	set("geocoding_url", config.GeocodingURL, loaded.GeocodingURL)
+	set("geocoding_email", config.GeocodingEmail, loaded.GeocodingEmail)
+	set("geocoding_cache_ttl", config.GeocodingCacheTTL, loaded.GeocodingCacheTTL)
+	set("smtp.host", config.SMTP.Host, loaded.SMTP.Host)
+	set("smtp.port", config.SMTP.Port, loaded.SMTP.Port)
+	set("smtp.username", config.SMTP.Username, loaded.SMTP.Username)
+	set("smtp.password", config.SMTP.Password, loaded.SMTP.Password)
+	set("smtp.from", config.SMTP.From, loaded.SMTP.From)
+	set("digest.recipients", config.Digest.Recipients, loaded.Digest.Recipients)
+	set("display.no_color", config.Display.NoColor, loaded.Display.NoColor)
+	set("display.no_emoji", config.Display.NoEmoji, loaded.Display.NoEmoji)
+	set("display.screen_reader", config.Display.ScreenReader, loaded.Display.ScreenReader)
+	set("display.theme", config.Display.Theme, loaded.Display.Theme)
+	set("display.clock", config.Display.Clock, loaded.Display.Clock)
+	set("display.seconds", config.Display.Seconds, loaded.Display.Seconds)
+	set("display.pasaran", config.Display.Pasaran, loaded.Display.Pasaran)
 
-			return locationName, nil
-		}
-	} else {
-		var r struct {
-			DisplayName string `json:"display_name"`
-		}
-		if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
-			return "", err
-		}
-		if r.DisplayName != "" {
-			return r.DisplayName, nil
-		}
+	viper.Set("profiles", profileSettings(config.Profiles))
+	viper.Set("formats", config.Formats)
+	viper.Set("themes", themeSettings(config.Themes))
+	settings["profiles"] = profileSettings(config.Profiles)
+	delete(settings, "formats")
+	if len(config.Formats) > 0 {
+		settings["formats"] = config.Formats
+	}
+	delete(settings, "themes")
+	if len(config.Themes) > 0 {
+		settings["themes"] = themeSettings(config.Themes)
 	}
+	for _, key := range locationKeys {
+		delete(settings, key)
+	}
+	return WriteSettings(path, settings)
+}
 
-	return "", fmt.Errorf("no location found for coordinates %f, %f", lat, lon)
+// DetectTimezone attempts to detect the system timezone
+func DetectTimezone() (*time.Location, error) {
+	// Get local timezone
+	return time.LoadLocation("")
 }
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestSaveConfigLeavesOutEnvironment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	file := "version: 1\n" +
+		"active_profile: default\n" +
+		"language: ms\n" +
+		"profiles:\n" +
+		"  default:\n" +
+		"    timezone: Asia/Jakarta\n" +
+		"    latitude: -6.2\n" +
+		"    longitude: 106.8\n" +
+		"    method: Kemenag\n"
+	if err := os.WriteFile(path, []byte(file), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("SALAT_SMTP_PASSWORD", "topsecret")
+	t.Setenv("SALAT_GEOCODING_API", "photon")
+	t.Setenv("SALAT_LANGUAGE", "en")
+	t.Cleanup(viper.Reset)
+	viper.SetConfigFile(path)
+	BindEnv()
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadConfigUnvalidated()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.SMTP.Password != "topsecret" || cfg.Language != "en" {
+		t.Fatalf("environment not in effect: %+v", cfg)
+	}
+	cfg.Method = "MWL"
+	cfg.SMTP.Host = "smtp.example.com"
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	settings, err := ReadSettings(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	smtp, _ := settings["smtp"].(map[string]interface{})
+	if _, ok := smtp["password"]; ok {
+		t.Errorf("smtp.password = %v, want it left out", smtp["password"])
+	}
+	if smtp["host"] != "smtp.example.com" {
+		t.Errorf("smtp.host = %v, want the change saved", smtp["host"])
+	}
+	if _, ok := settings["geocoding_api"]; ok {
+		t.Errorf("geocoding_api = %v, want it left out", settings["geocoding_api"])
+	}
+	if settings["language"] != "ms" {
+		t.Errorf("language = %v, want the file's own ms", settings["language"])
+	}
+	profile := settings["profiles"].(map[string]interface{})[DefaultProfile].(map[string]interface{})
+	if profile["method"] != "MWL" {
+		t.Errorf("profiles.default.method = %v, want MWL", profile["method"])
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0600 {
+		t.Errorf("mode = %v, want 0600", mode)
+	}
+}
+
+func TestSaveConfigWritesChangedEnvironmentKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv("SALAT_LANGUAGE", "en")
+	t.Cleanup(viper.Reset)
+	viper.SetConfigFile(path)
+	BindEnv()
+
+	cfg, err := LoadConfigUnvalidated()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Language = "ar"
+	if err := SaveConfig(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	settings, err := ReadSettings(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings["language"] != "ar" {
+		t.Errorf("language = %v, want the change saved", settings["language"])
+	}
+}
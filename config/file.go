@@ -0,0 +1,194 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"jadwalsalat/i18n"
+
+	"github.com/spf13/viper"
+)
+
+// SecretKeys are left out of exported bundles unless asked for
+var SecretKeys = []string{"smtp.password"}
+
+// ConfigPath returns the config file in use: the one given with --config,
+// otherwise config.yaml in the config directory
+func ConfigPath() (string, error) {
+	if path := viper.ConfigFileUsed(); path != "" {
+		return path, nil
+	}
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "config.yaml"), nil
+}
+
+// FileError reports a config file that cannot be parsed, or settings that
+// cannot be decoded into a Config
+type FileError struct {
+	Path string // empty when the settings, e.g. from the environment, cannot be decoded
+	Err  error
+}
+
+func (e *FileError) Error() string {
+	if e.Path == "" {
+		return i18n.Sprintf("tidak dapat membaca isi konfigurasi: %v", e.Err)
+	}
+	return i18n.Sprintf("tidak dapat membaca file konfigurasi %s: %v", e.Path, e.Err)
+}
+
+func (e *FileError) Unwrap() error { return e.Err }
+
+// readErr is the error of the last ReadInConfig, returned by LoadBaseConfig
+var readErr error
+
+// ReadInConfig reads the config file into viper. A missing file is not an
+// error since there is none before setup. A file that cannot be read is
+// returned as a *FileError, which LoadBaseConfig keeps returning so it is
+// never mistaken for a missing configuration.
+func ReadInConfig() error {
+	readErr = nil
+	err := viper.ReadInConfig()
+	if err == nil || IsNotExist(err) {
+		return nil
+	}
+	readErr = &FileError{Path: viper.ConfigFileUsed(), Err: err}
+	return readErr
+}
+
+// ReadSettings reads the settings of a config file, without values from
+// flags or the environment
+func ReadSettings(path string) (map[string]interface{}, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	return v.AllSettings(), nil
+}
+
+// IsNotExist reports whether an error from ReadSettings means there is no
+// config file, rather than one that cannot be read
+func IsNotExist(err error) bool {
+	var notFound viper.ConfigFileNotFoundError
+	return errors.Is(err, fs.ErrNotExist) || errors.As(err, &notFound)
+}
+
+// DecodeSettings reads settings in the given format ("yaml" or "json") and
+// upgrades them to CurrentVersion
+func DecodeSettings(r io.Reader, format string) (map[string]interface{}, error) {
+	v := viper.New()
+	v.SetConfigType(format)
+	if err := v.ReadConfig(r); err != nil {
+		return nil, err
+	}
+	settings := v.AllSettings()
+	if _, err := Migrate(settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// EncodeSettings writes settings to w in the given format ("yaml" or "json").
+// Secret keys are removed from settings unless secrets is set, so importing
+// the result keeps the secrets already configured.
+func EncodeSettings(w io.Writer, settings map[string]interface{}, format string, secrets bool) error {
+	if !secrets {
+		for _, key := range SecretKeys {
+			deleteKey(settings, key)
+		}
+	}
+	v := viper.New()
+	v.SetConfigType(format)
+	for key, value := range settings {
+		v.Set(key, value)
+	}
+	return v.WriteConfigTo(w)
+}
+
+// deleteKey removes a dotted key such as "smtp.password" from nested settings
+func deleteKey(settings map[string]interface{}, key string) {
+	parts := strings.Split(key, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := settings[part].(map[string]interface{})
+		if !ok {
+			return
+		}
+		settings = next
+	}
+	delete(settings, parts[len(parts)-1])
+}
+
+// setKey sets a dotted key such as "smtp.password" in nested settings
+func setKey(settings map[string]interface{}, key string, value interface{}) {
+	parts := strings.Split(key, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := settings[part].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			settings[part] = next
+		}
+		settings = next
+	}
+	settings[parts[len(parts)-1]] = value
+}
+
+// WriteSettings replaces the config file at path with settings. The file is
+// only readable by its owner, as it may hold the SMTP password.
+func WriteSettings(path string, settings map[string]interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	v := viper.New()
+	for key, value := range settings {
+		v.Set(key, value)
+	}
+	if err := v.WriteConfigAs(path); err != nil {
+		return err
+	}
+	return os.Chmod(path, 0600)
+}
+
+// ValidateSettings checks settings the way they would be loaded, including
+// every profile. Invalid values are reported as a *ValidationError.
+func ValidateSettings(settings map[string]interface{}) error {
+	v := viper.New()
+	if err := v.MergeConfigMap(settings); err != nil {
+		return err
+	}
+	var config Config
+	if err := v.Unmarshal(&config); err != nil {
+		return i18n.Errorf("tidak dapat membaca isi konfigurasi: %v", err)
+	}
+	return config.ValidateAll()
+}
+
+// BackupConfig copies the config file at path next to it with the current
+// time in its name, and returns the copy's path
+func BackupConfig(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	backup := fmt.Sprintf("%s.%s.bak", path, time.Now().Format("20060102-150405"))
+	if err := os.WriteFile(backup, data, 0600); err != nil {
+		return "", err
+	}
+	return backup, nil
+}
+
+// FormatFromPath returns "json" for .json files and "yaml" otherwise
+func FormatFromPath(path string) string {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return "json"
+	}
+	return "yaml"
+}
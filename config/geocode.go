@@ -0,0 +1,168 @@
+package config
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"jadwalsalat/geocode"
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+
+	"github.com/spf13/viper"
+)
+
+// geocodeTimeout bounds a single geocoding lookup
+const geocodeTimeout = 10 * time.Second
+
+// OfflineGeocoder is the provider backed by the embedded gazetteer
+const OfflineGeocoder = "offline"
+
+// NewGeocoder creates the geocoding provider named by apiType.
+// The configured base URL and contact email are applied when apiType is the
+// provider selected in the config file, so a self-hosted Nominatim URL is
+// never sent to Photon. Results of online providers are cached in the config
+// directory, and the providers fall back to the offline gazetteer when they
+// fail, e.g. without connectivity.
+func NewGeocoder(apiType string) (geocode.Geocoder, error) {
+	if apiType == "" {
+		apiType = "nominatim"
+	}
+
+	opts := providerOptions(apiType)
+	g, err := geocode.New(apiType, opts)
+	if err != nil || apiType == OfflineGeocoder {
+		return g, err
+	}
+
+	ttl, err := GeocodingCacheTTL(viper.GetString("geocoding_cache_ttl"))
+	if err != nil {
+		return nil, err
+	}
+	if ttl > 0 && opts.DataDir != "" {
+		name := apiType
+		if opts.BaseURL != "" {
+			name += "@" + opts.BaseURL
+		}
+		g = geocode.NewCache(g, name, filepath.Join(opts.DataDir, geocode.CacheFile), ttl)
+	}
+
+	offline, err := geocode.New(OfflineGeocoder, opts)
+	if err != nil {
+		return nil, err
+	}
+	return geocode.Fallback{g, offline}, nil
+}
+
+// providerOptions returns the configured options for the provider apiType
+func providerOptions(apiType string) geocode.Options {
+	opts := geocode.Options{
+		Email: viper.GetString("geocoding_email"),
+	}
+	if dir, err := GetConfigDir(); err == nil {
+		opts.DataDir = dir
+	}
+
+	configuredAPI := viper.GetString("geocoding_api")
+	if configuredAPI == "" {
+		configuredAPI = "nominatim"
+	}
+	if configuredAPI == apiType {
+		opts.BaseURL = viper.GetString("geocoding_url")
+	}
+	return opts
+}
+
+// PingGeocoder looks up the coordinates with the provider apiType directly,
+// without the cache, retries, or offline fallback, and returns how long the
+// lookup took. It reports whether the provider is reachable.
+func PingGeocoder(ctx context.Context, apiType string, lat, lon float64) (time.Duration, error) {
+	if apiType == "" {
+		apiType = "nominatim"
+	}
+	opts := providerOptions(apiType)
+	opts.MaxRetries = -1
+
+	g, err := geocode.New(apiType, opts)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	_, err = g.Reverse(ctx, lat, lon)
+	return time.Since(start), err
+}
+
+// GeocodingCacheTTL parses the geocoding_cache_ttl setting. An empty value
+// means the default TTL and "0" disables the cache.
+func GeocodingCacheTTL(value string) (time.Duration, error) {
+	if value == "" {
+		return geocode.DefaultCacheTTL, nil
+	}
+	ttl, err := time.ParseDuration(value)
+	if err != nil || ttl < 0 {
+		return 0, i18n.Errorf("geocoding_cache_ttl tidak valid %q: gunakan durasi seperti 720h, atau 0 untuk menonaktifkan", value)
+	}
+	return ttl, nil
+}
+
+// ForwardGeocode converts an address to coordinates using the specified API
+func ForwardGeocode(apiType, query string) (float64, float64, string, error) {
+	g, err := NewGeocoder(apiType)
+	if err != nil {
+		return 0, 0, "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), geocodeTimeout)
+	defer cancel()
+
+	r, err := geocode.Forward(ctx, g, query)
+	if err != nil {
+		return 0, 0, "", err
+	}
+	return r.Latitude, r.Longitude, r.Name, nil
+}
+
+// SearchLocations returns up to limit candidates for query, best match first
+func SearchLocations(apiType, query string, limit int) ([]geocode.Result, error) {
+	g, err := NewGeocoder(apiType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), geocodeTimeout)
+	defer cancel()
+
+	return g.Search(ctx, query, limit)
+}
+
+// ReverseGeocode converts coordinates to a location name
+func ReverseGeocode(apiType string, lat, lon float64) (string, error) {
+	g, err := NewGeocoder(apiType)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), geocodeTimeout)
+	defer cancel()
+
+	r, err := g.Reverse(ctx, lat, lon)
+	if err != nil {
+		return "", err
+	}
+	return r.Name, nil
+}
+
+// ResolveMethod returns the calculation method to use at the coordinates.
+// The "auto" method resolves to the convention of the country there.
+func ResolveMethod(method string, lat, lon float64) salat.CalculationMethod {
+	if !strings.EqualFold(method, string(salat.Auto)) {
+		return salat.CalculationMethod(method)
+	}
+	country, err := geocode.Country(lat, lon)
+	if err != nil {
+		return salat.MWL
+	}
+	return salat.MethodForCountry(country)
+}
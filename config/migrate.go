@@ -0,0 +1,169 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"jadwalsalat/i18n"
+
+	"github.com/spf13/viper"
+)
+
+// CurrentVersion is the schema version of config.yaml written by this build
+const CurrentVersion = 1
+
+// migration upgrades the settings of a config file by one version, in place
+type migration func(settings map[string]interface{}) error
+
+// migrations[i] upgrades a config file from version i to version i+1. Files
+// without a version field are version 0.
+var migrations = []migration{
+	migrateLocationToProfile,
+}
+
+// locationKeys are the settings that describe a location
+var locationKeys = []string{"timezone", "latitude", "longitude", "method", "location_name", "adjustments"}
+
+// Migrate upgrades settings read from a config file to CurrentVersion, in
+// place, and returns the version the settings had
+func Migrate(settings map[string]interface{}) (int, error) {
+	version, err := settingsVersion(settings)
+	if err != nil {
+		return 0, err
+	}
+	if version > CurrentVersion {
+		return version, i18n.Errorf("versi konfigurasi %d lebih baru dari yang didukung salat ini (%d), perbarui salat", version, CurrentVersion)
+	}
+
+	for v := version; v < CurrentVersion; v++ {
+		if err := migrations[v](settings); err != nil {
+			return version, i18n.Errorf("gagal memperbarui konfigurasi ke versi %d: %v", v+1, err)
+		}
+	}
+	settings["version"] = CurrentVersion
+	return version, nil
+}
+
+// MigrateConfigFile upgrades the config file viper has read to
+// CurrentVersion. The original is kept next to it as e.g. config.yaml.v0.bak,
+// whose path is returned; it is empty when the file was already current.
+func MigrateConfigFile() (string, error) {
+	path := viper.ConfigFileUsed()
+	if path == "" {
+		return "", nil
+	}
+	original, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", i18n.Errorf("gagal membaca file konfigurasi: %v", err)
+	}
+
+	// Read the file on its own so values from flags or the environment are not written
+	file := viper.New()
+	file.SetConfigFile(path)
+	if err := file.ReadInConfig(); err != nil {
+		return "", i18n.Errorf("gagal membaca file konfigurasi: %v", err)
+	}
+	settings := file.AllSettings()
+	version, err := Migrate(settings)
+	if err != nil || version == CurrentVersion {
+		return "", err
+	}
+
+	// An existing backup is older than this one, so it is kept
+	backup := fmt.Sprintf("%s.v%d.bak", path, version)
+	if err := writeBackup(backup, original); err != nil {
+		return "", i18n.Errorf("gagal mencadangkan file konfigurasi: %v", err)
+	}
+
+	if err := WriteSettings(path, settings); err != nil {
+		return "", i18n.Errorf("gagal menulis file konfigurasi yang diperbarui: %v", err)
+	}
+	if err := viper.ReadInConfig(); err != nil {
+		return "", i18n.Errorf("gagal membaca file konfigurasi yang diperbarui: %v", err)
+	}
+	return backup, nil
+}
+
+// writeBackup writes data to path unless the file already exists
+func writeBackup(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if os.IsExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// settingsVersion returns the version field of settings, 0 when missing
+func settingsVersion(settings map[string]interface{}) (int, error) {
+	switch v := settings["version"].(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), nil
+		}
+	}
+	return 0, i18n.Errorf("versi konfigurasi tidak valid: %v", settings["version"])
+}
+
+// migrateLocationToProfile moves the top-level location of version 0 files
+// into profiles.default and makes it the active profile. When a profile is
+// already named default, the location gets the first free default-N name.
+func migrateLocationToProfile(settings map[string]interface{}) error {
+	location := map[string]interface{}{}
+	for _, key := range locationKeys {
+		if value, ok := settings[key]; ok {
+			location[key] = value
+			delete(settings, key)
+		}
+	}
+	if !hasLocationSettings(location) {
+		return nil
+	}
+
+	profiles, ok := settings["profiles"].(map[string]interface{})
+	if !ok {
+		if settings["profiles"] != nil {
+			return i18n.Errorf("profiles harus berupa map, bukan %T", settings["profiles"])
+		}
+		profiles = map[string]interface{}{}
+	}
+
+	name := DefaultProfile
+	for i := 1; profiles[name] != nil; i++ {
+		name = fmt.Sprintf("%s-%d", DefaultProfile, i)
+	}
+	profiles[name] = location
+	settings["profiles"] = profiles
+
+	// Without an active profile the top-level location was in effect
+	if active, _ := settings["active_profile"].(string); active == "" {
+		settings["active_profile"] = name
+	}
+	return nil
+}
+
+// hasLocationSettings reports whether location settings name a location,
+// like Config.HasLocation
+func hasLocationSettings(location map[string]interface{}) bool {
+	for _, key := range []string{"timezone", "latitude", "longitude", "location_name"} {
+		if value := location[key]; value != nil && fmt.Sprint(value) != "" && fmt.Sprint(value) != "0" {
+			return true
+		}
+	}
+	return false
+}
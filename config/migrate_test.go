@@ -0,0 +1,90 @@
+package config
+
+import "testing"
+
+func TestMigrationsMatchVersion(t *testing.T) {
+	if len(migrations) != CurrentVersion {
+		t.Errorf("%d migrations for version %d", len(migrations), CurrentVersion)
+	}
+}
+
+func TestMigrateFlatLocation(t *testing.T) {
+	settings := map[string]interface{}{
+		"timezone":      "Asia/Jakarta",
+		"latitude":      -6.2,
+		"longitude":     106.8,
+		"method":        "Kemenag",
+		"location_name": "Jakarta",
+		"geocoding_api": "photon",
+	}
+	version, err := Migrate(settings)
+	if err != nil || version != 0 {
+		t.Fatalf("Migrate = %d, %v; expected 0, nil", version, err)
+	}
+
+	if settings["version"] != CurrentVersion {
+		t.Errorf("version = %v; expected %d", settings["version"], CurrentVersion)
+	}
+	if _, ok := settings["timezone"]; ok {
+		t.Error("top-level timezone was not removed")
+	}
+	if settings["geocoding_api"] != "photon" {
+		t.Errorf("geocoding_api = %v; expected it untouched", settings["geocoding_api"])
+	}
+	profiles := settings["profiles"].(map[string]interface{})
+	p := profiles[DefaultProfile].(map[string]interface{})
+	if p["timezone"] != "Asia/Jakarta" || p["latitude"] != -6.2 || p["location_name"] != "Jakarta" {
+		t.Errorf("profiles.default = %v", p)
+	}
+	if settings["active_profile"] != DefaultProfile {
+		t.Errorf("active_profile = %v; expected %s", settings["active_profile"], DefaultProfile)
+	}
+}
+
+func TestMigrateKeepsExistingDefaultProfile(t *testing.T) {
+	existing := map[string]interface{}{"timezone": "Asia/Makassar"}
+	settings := map[string]interface{}{
+		"timezone":       "Asia/Jakarta",
+		"latitude":       -6.2,
+		"active_profile": "kantor",
+		"profiles":       map[string]interface{}{DefaultProfile: existing, "kantor": map[string]interface{}{}},
+	}
+	if _, err := Migrate(settings); err != nil {
+		t.Fatal(err)
+	}
+
+	profiles := settings["profiles"].(map[string]interface{})
+	if profiles[DefaultProfile].(map[string]interface{})["timezone"] != "Asia/Makassar" {
+		t.Errorf("profiles.default was overwritten: %v", profiles[DefaultProfile])
+	}
+	if p, ok := profiles["default-1"].(map[string]interface{}); !ok || p["timezone"] != "Asia/Jakarta" {
+		t.Errorf("profiles.default-1 = %v", profiles["default-1"])
+	}
+	if settings["active_profile"] != "kantor" {
+		t.Errorf("active_profile = %v; expected kantor", settings["active_profile"])
+	}
+}
+
+func TestMigrateWithoutLocation(t *testing.T) {
+	settings := map[string]interface{}{"geocoding_api": "offline"}
+	if _, err := Migrate(settings); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := settings["profiles"]; ok {
+		t.Errorf("profiles = %v; expected none without a location", settings["profiles"])
+	}
+}
+
+func TestMigrateCurrentAndNewer(t *testing.T) {
+	settings := map[string]interface{}{"version": CurrentVersion, "timezone": "Asia/Jakarta"}
+	if version, err := Migrate(settings); err != nil || version != CurrentVersion {
+		t.Fatalf("Migrate = %d, %v; expected %d, nil", version, err, CurrentVersion)
+	}
+	if settings["timezone"] != "Asia/Jakarta" {
+		t.Error("current settings were migrated again")
+	}
+
+	if _, err := Migrate(map[string]interface{}{"version": CurrentVersion + 1}); err == nil {
+		t.Error("expected an error for a newer version")
+	}
+}
@@ -0,0 +1,97 @@
+package config
+
+import (
+	"os"
+	"strings"
+
+	"jadwalsalat/geocode"
+	"jadwalsalat/salat"
+
+	"github.com/spf13/viper"
+)
+
+// EnvPrefix is the prefix of environment variables that set config keys,
+// e.g. SALAT_LATITUDE or SALAT_ADJUSTMENTS_SUBUH for adjustments.subuh
+const EnvPrefix = "SALAT"
+
+// envKeys are the config keys that can be set from the environment
+var envKeys = []string{
+	"timezone", "latitude", "longitude", "method", "location_name",
+	"geocoding_api", "geocoding_url", "geocoding_email", "geocoding_cache_ttl",
+	"smtp.host", "smtp.port", "smtp.username", "smtp.password", "smtp.from",
+	"digest.recipients", "active_profile", "language",
+	"display.no_color", "display.no_emoji", "display.screen_reader", "display.theme",
+	"display.clock", "display.seconds", "display.pasaran",
+}
+
+// BindEnv makes SALAT_ environment variables override config keys, with
+// dots in nested keys replaced by underscores
+func BindEnv() {
+	viper.SetEnvPrefix(EnvPrefix)
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.AutomaticEnv()
+
+	// Keys missing from the config file are only unmarshaled when bound
+	for _, key := range envKeys {
+		viper.BindEnv(key)
+	}
+	for _, name := range salat.AdjustmentNames {
+		viper.BindEnv("adjustments." + name)
+	}
+}
+
+// envSet reports whether the SALAT_ environment variable of key is set
+func envSet(key string) bool {
+	_, ok := os.LookupEnv(EnvPrefix + "_" + strings.ToUpper(strings.ReplaceAll(key, ".", "_")))
+	return ok
+}
+
+// applyOverrides puts the location settings given for this run, by flags
+// bound to their keys or by SALAT_ environment variables, over the profile
+// in effect. It reports whether any setting was overridden.
+func (c *Config) applyOverrides() bool {
+	overridden := false
+	set := func(key string) bool {
+		if viper.IsSet(key) {
+			overridden = true
+			return true
+		}
+		return false
+	}
+
+	lat, lon := set("latitude"), set("longitude")
+	if lat {
+		c.Latitude = viper.GetFloat64("latitude")
+	}
+	if lon {
+		c.Longitude = viper.GetFloat64("longitude")
+	}
+	if lat || lon {
+		// The saved name, method, and adjustments belong to the saved coordinates
+		c.LocationName = ""
+		c.Method = string(salat.Auto)
+		c.Adjustments = salat.Adjustments{}
+	}
+	if set("location_name") {
+		c.LocationName = viper.GetString("location_name")
+	}
+
+	if set("timezone") {
+		c.Timezone = viper.GetString("timezone")
+	} else if lat || lon {
+		if tz, err := geocode.Timezone(c.Latitude, c.Longitude); err == nil {
+			c.Timezone = tz
+		}
+	}
+
+	if set("method") {
+		c.Method = viper.GetString("method")
+	}
+
+	for _, name := range salat.AdjustmentNames {
+		if key := "adjustments." + name; set(key) {
+			c.Adjustments.Set(name, viper.GetInt(key))
+		}
+	}
+	return overridden
+}
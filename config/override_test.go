@@ -0,0 +1,108 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+const overrideConfig = `version: 1
+active_profile: kantor
+profiles:
+  default:
+    timezone: Asia/Jakarta
+    latitude: -6.2
+    longitude: 106.8
+    method: Kemenag
+  kantor:
+    timezone: Asia/Makassar
+    latitude: -5.1
+    longitude: 119.4
+    method: MWL
+    location_name: Makassar
+    adjustments:
+      subuh: 2
+      isya: -1
+`
+
+// loadWithEnv loads overrideConfig with the given SALAT_ variables set
+func loadWithEnv(t *testing.T, env map[string]string) *Config {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(overrideConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+	for key, value := range env {
+		t.Setenv(key, value)
+	}
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.SetConfigFile(path)
+	BindEnv()
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+func TestAdjustmentPrecedence(t *testing.T) {
+	t.Run("profile", func(t *testing.T) {
+		cfg := loadWithEnv(t, nil)
+		if cfg.Adjustments.Subuh != 2 || cfg.Adjustments.Isya != -1 {
+			t.Errorf("profile adjustments = %+v", cfg.Adjustments)
+		}
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		// One adjustment is replaced and the rest kept
+		cfg := loadWithEnv(t, map[string]string{"SALAT_ADJUSTMENTS_SUBUH": "5"})
+		if cfg.Adjustments.Subuh != 5 || cfg.Adjustments.Isya != -1 {
+			t.Errorf("adjustments with SALAT_ADJUSTMENTS_SUBUH = %+v", cfg.Adjustments)
+		}
+		if cfg.Method != "MWL" || cfg.LocationName != "Makassar" {
+			t.Errorf("method %q and name %q changed by an adjustment", cfg.Method, cfg.LocationName)
+		}
+	})
+
+	t.Run("coordinates", func(t *testing.T) {
+		// The saved adjustments, method, and name belong to the saved
+		// coordinates; adjustments given for this run still apply
+		cfg := loadWithEnv(t, map[string]string{
+			"SALAT_LATITUDE":         "21.42",
+			"SALAT_LONGITUDE":        "39.83",
+			"SALAT_TIMEZONE":         "Asia/Riyadh",
+			"SALAT_ADJUSTMENTS_ISYA": "3",
+		})
+		if cfg.Adjustments.Subuh != 0 || cfg.Adjustments.Isya != 3 {
+			t.Errorf("adjustments with other coordinates = %+v", cfg.Adjustments)
+		}
+		if cfg.Method != "auto" || cfg.LocationName != "" || cfg.Timezone != "Asia/Riyadh" {
+			t.Errorf("location with other coordinates = %+v", cfg.CurrentProfile())
+		}
+	})
+
+	t.Run("selected profile", func(t *testing.T) {
+		SelectProfile(DefaultProfile)
+		t.Cleanup(func() { SelectProfile("") })
+		cfg := loadWithEnv(t, nil)
+		if cfg.Adjustments.Subuh != 0 || cfg.Method != "Kemenag" {
+			t.Errorf("default profile = %+v", cfg.CurrentProfile())
+		}
+	})
+}
+
+func TestEnvSet(t *testing.T) {
+	t.Setenv("SALAT_SMTP_PASSWORD", "")
+	if !envSet("smtp.password") {
+		t.Error("envSet(smtp.password) = false for a set, empty variable")
+	}
+	if envSet("smtp.username") {
+		t.Error("envSet(smtp.username) = true without SALAT_SMTP_USERNAME")
+	}
+}
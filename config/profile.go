@@ -0,0 +1,121 @@
+package config
+
+import (
+	"regexp"
+	"sort"
+
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+)
+
+// DefaultProfile names the profile created by setup
+const DefaultProfile = "default"
+
+// profileNamePattern restricts profile names to what works as a config key
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Profile is a named location with its own timezone, method, and adjustments
+type Profile struct {
+	Timezone     string            `mapstructure:"timezone"`
+	Latitude     float64           `mapstructure:"latitude"`
+	Longitude    float64           `mapstructure:"longitude"`
+	Method       string            `mapstructure:"method"`
+	LocationName string            `mapstructure:"location_name"`
+	Adjustments  salat.Adjustments `mapstructure:"adjustments"`
+}
+
+// ValidateProfileName checks that name can be stored as a profile
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return i18n.Errorf("nama profil tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'", name)
+	}
+	return nil
+}
+
+// ProfileNames returns the saved profile names in alphabetical order
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UseProfile makes the location of the named profile the one in effect. An
+// empty name selects the default profile; when there is no default profile
+// the top-level location of an unmigrated file stays in effect.
+func (c *Config) UseProfile(name string) error {
+	if name == "" {
+		name = DefaultProfile
+	}
+	p, ok := c.Profiles[name]
+	if !ok {
+		if name == DefaultProfile {
+			return nil
+		}
+		return i18n.Errorf("profil %q tidak ditemukan", name)
+	}
+
+	c.Profile = name
+	c.Timezone = p.Timezone
+	c.Latitude = p.Latitude
+	c.Longitude = p.Longitude
+	c.Method = p.Method
+	c.LocationName = p.LocationName
+	c.Adjustments = p.Adjustments
+	return nil
+}
+
+// SalatLocation returns the location used to calculate prayer times
+func (c *Config) SalatLocation() salat.Location {
+	return c.CurrentProfile().SalatLocation()
+}
+
+// SalatLocation returns the location used to calculate the profile's prayer times
+func (p Profile) SalatLocation() salat.Location {
+	return salat.Location{
+		Latitude:    p.Latitude,
+		Longitude:   p.Longitude,
+		Method:      ResolveMethod(p.Method, p.Latitude, p.Longitude),
+		Adjustments: p.Adjustments,
+	}
+}
+
+// CurrentProfile returns the location in effect as a profile
+func (c *Config) CurrentProfile() Profile {
+	return Profile{
+		Timezone:     c.Timezone,
+		Latitude:     c.Latitude,
+		Longitude:    c.Longitude,
+		Method:       c.Method,
+		LocationName: c.LocationName,
+		Adjustments:  c.Adjustments,
+	}
+}
+
+// profileSettings converts profiles to the nested maps written to config.yaml
+func profileSettings(profiles map[string]Profile) map[string]interface{} {
+	settings := map[string]interface{}{}
+	for name, p := range profiles {
+		settings[name] = map[string]interface{}{
+			"timezone":      p.Timezone,
+			"latitude":      p.Latitude,
+			"longitude":     p.Longitude,
+			"method":        p.Method,
+			"location_name": p.LocationName,
+			"adjustments":   adjustmentSettings(p.Adjustments),
+		}
+	}
+	return settings
+}
+
+// adjustmentSettings converts adjustments to the map written to config.yaml
+func adjustmentSettings(a salat.Adjustments) map[string]interface{} {
+	settings := map[string]interface{}{}
+	for _, name := range salat.AdjustmentNames {
+		minutes, _ := a.Get(name)
+		settings[name] = minutes
+	}
+	return settings
+}
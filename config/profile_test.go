@@ -0,0 +1,92 @@
+package config
+
+import (
+	"testing"
+
+	"jadwalsalat/salat"
+)
+
+func testProfiles() *Config {
+	return &Config{
+		ActiveProfile: "kantor",
+		Profiles: map[string]Profile{
+			DefaultProfile: {Timezone: "Asia/Jakarta", Latitude: -6.2, Longitude: 106.8, Method: "Kemenag", LocationName: "Jakarta"},
+			"kantor": {
+				Timezone: "Asia/Makassar", Latitude: -5.1, Longitude: 119.4, Method: "auto", LocationName: "Makassar",
+				Adjustments: salat.Adjustments{Subuh: 2, Isya: -1},
+			},
+		},
+	}
+}
+
+func TestUseProfile(t *testing.T) {
+	c := testProfiles()
+	if err := c.UseProfile("kantor"); err != nil {
+		t.Fatal(err)
+	}
+	if c.Profile != "kantor" || c.LocationName != "Makassar" || c.Adjustments.Subuh != 2 {
+		t.Errorf("after UseProfile(kantor): %+v", c.CurrentProfile())
+	}
+
+	// An empty name is the default profile
+	if err := c.UseProfile(""); err != nil {
+		t.Fatal(err)
+	}
+	if c.Profile != DefaultProfile || c.LocationName != "Jakarta" || c.Adjustments != (salat.Adjustments{}) {
+		t.Errorf("after UseProfile(\"\"): %q %+v", c.Profile, c.CurrentProfile())
+	}
+
+	if err := c.UseProfile("rumah"); err == nil {
+		t.Error("UseProfile(rumah) succeeded for a missing profile")
+	}
+
+	// Without a default profile the top-level location of an unmigrated
+	// file stays in effect
+	flat := &Config{Timezone: "Asia/Jakarta", Latitude: -6.2, LocationName: "Jakarta"}
+	if err := flat.UseProfile(""); err != nil {
+		t.Fatal(err)
+	}
+	if flat.Profile != "" || flat.LocationName != "Jakarta" {
+		t.Errorf("unmigrated location replaced: %q %+v", flat.Profile, flat.CurrentProfile())
+	}
+}
+
+func TestSelectedProfile(t *testing.T) {
+	t.Cleanup(func() { SelectProfile("") })
+	c := testProfiles()
+	if got := c.SelectedProfile(); got != "kantor" {
+		t.Errorf("SelectedProfile = %q, want the active kantor", got)
+	}
+	SelectProfile(DefaultProfile)
+	if got := c.SelectedProfile(); got != DefaultProfile {
+		t.Errorf("SelectedProfile with --profile = %q, want %s", got, DefaultProfile)
+	}
+}
+
+func TestProfileNames(t *testing.T) {
+	names := testProfiles().ProfileNames()
+	if len(names) != 2 || names[0] != DefaultProfile || names[1] != "kantor" {
+		t.Errorf("ProfileNames = %v", names)
+	}
+	for _, name := range []string{"kantor", "rumah-2", "a_b"} {
+		if err := ValidateProfileName(name); err != nil {
+			t.Errorf("ValidateProfileName(%q) = %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "Kantor", "-x", "a.b", "a b"} {
+		if err := ValidateProfileName(name); err == nil {
+			t.Errorf("ValidateProfileName(%q) succeeded", name)
+		}
+	}
+}
+
+func TestProfileSalatLocation(t *testing.T) {
+	p := testProfiles().Profiles["kantor"]
+	loc := p.SalatLocation()
+	if loc.Method != salat.Kemenag {
+		t.Errorf("auto method in Makassar = %v, want Kemenag", loc.Method)
+	}
+	if loc.Adjustments != p.Adjustments {
+		t.Errorf("Adjustments = %+v, want the profile's %+v", loc.Adjustments, p.Adjustments)
+	}
+}
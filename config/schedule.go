@@ -0,0 +1,95 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"jadwalsalat/salat"
+)
+
+// ScheduleCacheFile is the name of the schedule cache inside the config
+// directory
+const ScheduleCacheFile = "schedule-cache.json"
+
+// scheduleCacheVersion changes whenever the same settings would give
+// different times, so stale entries are recalculated
+const scheduleCacheVersion = 2
+
+// Schedule is the prayer times of a day and the method they were calculated
+// with, "auto" resolved
+type Schedule struct {
+	Method salat.CalculationMethod `json:"method"`
+	Times  salat.PrayerTimes       `json:"times"`
+}
+
+// scheduleEntry is a cached schedule and the settings it was calculated for
+type scheduleEntry struct {
+	Key  string `json:"key"`
+	Date string `json:"date"`
+	Schedule
+}
+
+// CachedSchedule returns the profile's prayer times on the date of t, read
+// from the cache file at path when it holds them and calculated and stored
+// otherwise. Status bars and prompts run it every few seconds; resolving the
+// "auto" method alone takes longer than reading the file. The cache only
+// keeps the entries of t's date, failing to write it is not an error, and an
+// empty path disables it.
+func CachedSchedule(path string, p Profile, t time.Time) (Schedule, error) {
+	_, offset := t.Zone()
+	key := fmt.Sprintf("%d|%.6f,%.6f|%s|%d|%+v", scheduleCacheVersion, p.Latitude, p.Longitude, p.Method, offset, p.Adjustments)
+	date := t.Format("2006-01-02")
+
+	var entries []scheduleEntry
+	if data, err := os.ReadFile(path); err == nil {
+		// A corrupt cache is recalculated like a missing one
+		_ = json.Unmarshal(data, &entries)
+	}
+	kept := entries[:0]
+	for _, e := range entries {
+		if e.Date != date {
+			continue
+		}
+		if e.Key == key {
+			return e.Schedule.In(t.Location()), nil
+		}
+		kept = append(kept, e)
+	}
+
+	location := p.SalatLocation()
+	times, err := salat.TimesForDate(t, location)
+	if err != nil {
+		return Schedule{}, err
+	}
+	s := Schedule{Method: location.Method, Times: times}
+
+	kept = append(kept, scheduleEntry{Key: key, Date: date, Schedule: s})
+	if data, err := json.Marshal(kept); err == nil && path != "" {
+		if os.MkdirAll(filepath.Dir(path), 0755) == nil {
+			_ = os.WriteFile(path, data, 0644)
+		}
+	}
+	return s, nil
+}
+
+// In returns the schedule with its times in loc; cached times only keep
+// their UTC offset
+func (s Schedule) In(loc *time.Location) Schedule {
+	t := &s.Times
+	for _, field := range []*time.Time{&t.Imsak, &t.Subuh, &t.Dzuhur, &t.Ashar, &t.Maghrib, &t.Isya} {
+		*field = field.In(loc)
+	}
+	return s
+}
+
+// SchedulePath returns the path of the schedule cache
+func SchedulePath() (string, error) {
+	dir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, ScheduleCacheFile), nil
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"jadwalsalat/salat"
+)
+
+func TestCachedSchedule(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ScheduleCacheFile)
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, 3, 1, 13, 0, 0, 0, loc)
+	p := Profile{Timezone: "Asia/Jakarta", Latitude: -6.2, Longitude: 106.8, Method: string(salat.Kemenag)}
+
+	first, err := CachedSchedule(path, p, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cached, err := CachedSchedule(path, p, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cached.Times.Dzuhur.Equal(first.Times.Dzuhur) || cached.Method != salat.Kemenag {
+		t.Errorf("cached schedule = %+v, want %+v", cached, first)
+	}
+	if cached.Times.Dzuhur.Location() != loc {
+		t.Errorf("cached times are in %v, want %v", cached.Times.Dzuhur.Location(), loc)
+	}
+
+	// A changed adjustment is calculated again instead of read from the cache
+	p.Adjustments.Dzuhur = 3
+	adjusted, err := CachedSchedule(path, p, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := adjusted.Times.Dzuhur.Sub(first.Times.Dzuhur); got != 3*time.Minute {
+		t.Errorf("adjusted Dzuhur moved by %v, want 3m", got)
+	}
+
+	// Entries of other dates are dropped
+	if _, err := CachedSchedule(path, p, now.AddDate(0, 0, 1)); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entries []scheduleEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Date != "2024-03-02" {
+		t.Errorf("cache holds %+v, want only 2024-03-02", entries)
+	}
+}
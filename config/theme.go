@@ -0,0 +1,42 @@
+package config
+
+import (
+	"sort"
+
+	"jadwalsalat/i18n"
+	"jadwalsalat/theme"
+)
+
+// ThemeNames returns the names of the user-defined themes, sorted
+func (c *Config) ThemeNames() []string {
+	names := make([]string, 0, len(c.Themes))
+	for name := range c.Themes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateThemeName checks that name can be used as a theme name
+func ValidateThemeName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return i18n.Errorf("nama tema tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'", name)
+	}
+	return nil
+}
+
+// themeSettings converts themes to the map written to config.yaml, leaving
+// out roles without a style
+func themeSettings(themes map[string]theme.Theme) map[string]interface{} {
+	settings := map[string]interface{}{}
+	for name, t := range themes {
+		roles := map[string]interface{}{}
+		for _, role := range theme.Roles {
+			if style, _ := t.Get(role); style != "" {
+				roles[role] = style
+			}
+		}
+		settings[name] = roles
+	}
+	return settings
+}
@@ -0,0 +1,203 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+
+	"jadwalsalat/format"
+	"jadwalsalat/geocode"
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+	"jadwalsalat/theme"
+)
+
+// maxAdjustment bounds prayer time adjustments; larger values are most
+// likely typos, e.g. seconds instead of minutes
+const maxAdjustment = 60
+
+// FieldError describes one invalid configuration value
+type FieldError struct {
+	Field   string
+	Value   interface{}
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return i18n.Sprintf("%s: %s (nilai: %v)", e.Field, e.Message, e.Value)
+}
+
+// ValidationError lists every invalid value of a configuration
+type ValidationError struct {
+	Fields []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	lines := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		lines[i] = "  - " + f.Error()
+	}
+	return i18n.T("konfigurasi tidak valid:") + "\n" + strings.Join(lines, "\n")
+}
+
+// HasLocation reports whether a location has been configured. Coordinates
+// alone are not enough since 0,0 is a valid location.
+func (c *Config) HasLocation() bool {
+	return c.Timezone != "" || c.LocationName != "" || c.Latitude != 0 || c.Longitude != 0
+}
+
+// Validate checks the location in effect and the global settings, and
+// returns a *ValidationError listing all invalid values, or nil
+func (c *Config) Validate() error {
+	var errs []*FieldError
+	add := func(field string, value interface{}, message string) {
+		errs = append(errs, &FieldError{Field: field, Value: value, Message: message})
+	}
+
+	if c.Version > CurrentVersion {
+		add("version", c.Version, i18n.Sprintf("lebih baru dari yang didukung salat ini (%d)", CurrentVersion))
+	}
+
+	// Overridden values are named by their own keys, not the profile's
+	if c.Profile != "" && !c.overridden {
+		errs = append(errs, c.CurrentProfile().Validate("profiles."+c.Profile+".")...)
+	} else if c.HasLocation() {
+		errs = append(errs, c.CurrentProfile().Validate("")...)
+	}
+	if c.Language != "" {
+		if _, err := i18n.Parse(c.Language); err != nil {
+			add("language", c.Language, i18n.Sprintf("harus salah satu dari %s", strings.Join(i18n.Codes(), ", ")))
+		}
+	}
+	if c.ActiveProfile != "" && c.ActiveProfile != DefaultProfile {
+		if _, ok := c.Profiles[c.ActiveProfile]; !ok {
+			add("active_profile", c.ActiveProfile, i18n.T("tidak ada profil dengan nama ini"))
+		}
+	}
+
+	if c.GeocodingAPI != "" {
+		if _, err := geocode.New(c.GeocodingAPI, geocode.Options{}); err != nil {
+			add("geocoding_api", c.GeocodingAPI, i18n.Sprintf("harus salah satu dari %s", strings.Join(geocode.Providers(), ", ")))
+		}
+	}
+	if c.GeocodingURL != "" {
+		if u, err := url.Parse(c.GeocodingURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			add("geocoding_url", c.GeocodingURL, i18n.T("harus berupa URL http atau https"))
+		}
+	}
+	if _, err := GeocodingCacheTTL(c.GeocodingCacheTTL); err != nil {
+		add("geocoding_cache_ttl", c.GeocodingCacheTTL, i18n.T("harus berupa durasi seperti 720h, atau 0 untuk menonaktifkan"))
+	}
+	if c.SMTP.Port < 0 || c.SMTP.Port > 65535 {
+		add("smtp.port", c.SMTP.Port, i18n.T("harus di antara 1 dan 65535"))
+	}
+	for i, r := range c.Digest.Recipients {
+		if !strings.Contains(r, "@") {
+			add(fmt.Sprintf("digest.recipients[%d]", i), r, i18n.T("harus berupa alamat email"))
+		}
+	}
+	for _, name := range c.FormatNames() {
+		if _, err := format.Parse(name, c.Formats[name]); err != nil {
+			add("formats."+name, c.Formats[name], i18n.Sprintf("template tidak valid: %v", err))
+		}
+	}
+	for _, name := range c.ThemeNames() {
+		if err := ValidateThemeName(name); err != nil {
+			add("themes."+name, name, i18n.T("gunakan huruf kecil, angka, '-' dan '_'"))
+		}
+		for _, role := range theme.Roles {
+			style, _ := c.Themes[name].Get(role)
+			if _, err := theme.ParseStyle(style); err != nil {
+				add("themes."+name+"."+role, style, err.Error())
+			}
+		}
+	}
+	if !ValidClock(c.Display.Clock) {
+		add("display.clock", c.Display.Clock, i18n.T("harus 12h atau 24h"))
+	}
+	if c.Display.Theme != "" {
+		if _, ok := theme.Lookup(c.Display.Theme, c.Themes); !ok {
+			add("display.theme", c.Display.Theme, i18n.Sprintf("harus salah satu dari %s", strings.Join(theme.Names(c.Themes), ", ")))
+		}
+	}
+
+	if len(errs) > 0 {
+		return &ValidationError{Fields: errs}
+	}
+	return nil
+}
+
+// ValidateAll checks the global settings, the top-level location, and every
+// profile of a configuration loaded without a profile in effect, and
+// returns a *ValidationError listing all invalid values, or nil
+func (c *Config) ValidateAll() error {
+	var errs []*FieldError
+	var valErr *ValidationError
+	if err := c.Validate(); errors.As(err, &valErr) {
+		errs = append(errs, valErr.Fields...)
+	}
+	for _, name := range c.ProfileNames() {
+		if err := ValidateProfileName(name); err != nil {
+			errs = append(errs, &FieldError{Field: "profiles." + name, Value: name, Message: i18n.T("gunakan huruf kecil, angka, '-' dan '_'")})
+		}
+		errs = append(errs, c.Profiles[name].Validate("profiles."+name+".")...)
+	}
+
+	if len(errs) > 0 {
+		return &ValidationError{Fields: errs}
+	}
+	return nil
+}
+
+// Validate checks the location settings of the profile, naming the fields
+// under prefix, e.g. "profiles.kantor."
+func (p Profile) Validate(prefix string) []*FieldError {
+	var errs []*FieldError
+	add := func(field string, value interface{}, message string) {
+		errs = append(errs, &FieldError{Field: prefix + field, Value: value, Message: message})
+	}
+
+	if math.IsNaN(p.Latitude) || p.Latitude < -90 || p.Latitude > 90 {
+		add("latitude", p.Latitude, i18n.T("harus di antara -90 dan 90"))
+	}
+	if math.IsNaN(p.Longitude) || p.Longitude < -180 || p.Longitude > 180 {
+		add("longitude", p.Longitude, i18n.T("harus di antara -180 dan 180"))
+	}
+
+	if p.Timezone == "" {
+		add("timezone", p.Timezone, i18n.T("wajib diisi"))
+	} else if _, err := time.LoadLocation(p.Timezone); err != nil {
+		add("timezone", p.Timezone, i18n.T("timezone tidak dikenal, gunakan nama IANA seperti Asia/Jakarta"))
+	}
+
+	if p.Method != "" && !ValidMethod(p.Method) {
+		names := make([]string, len(salat.Methods))
+		for i, m := range salat.Methods {
+			names[i] = string(m)
+		}
+		add("method", p.Method, i18n.Sprintf("harus salah satu dari %s, auto", strings.Join(names, ", ")))
+	}
+
+	for _, name := range salat.AdjustmentNames {
+		if minutes, _ := p.Adjustments.Get(name); minutes < -maxAdjustment || minutes > maxAdjustment {
+			add("adjustments."+name, minutes, i18n.Sprintf("harus di antara -%d dan %d menit", maxAdjustment, maxAdjustment))
+		}
+	}
+	return errs
+}
+
+// ValidMethod reports whether method is a calculation method that can be configured
+func ValidMethod(method string) bool {
+	if strings.EqualFold(method, string(salat.Auto)) {
+		return true
+	}
+	for _, m := range salat.Methods {
+		if string(m) == method {
+			return true
+		}
+	}
+	return false
+}
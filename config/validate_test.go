@@ -0,0 +1,126 @@
+package config
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"jadwalsalat/salat"
+)
+
+func TestValidMethod(t *testing.T) {
+	tests := []struct {
+		method string
+		want   bool
+	}{
+		{"Kemenag", true},
+		{"MWL", true},
+		{"JAKIM", true},
+		{"auto", true},
+		{"AUTO", true},
+		{"kemenag", false},
+		{"Kemenang", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := ValidMethod(tt.method); got != tt.want {
+			t.Errorf("ValidMethod(%q) = %v, want %v", tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestValidateAll(t *testing.T) {
+	jakarta := Profile{Timezone: "Asia/Jakarta", Latitude: -6.2, Longitude: 106.8, Method: "Kemenag"}
+	tests := []struct {
+		name string
+		cfg  Config
+		want []string
+	}{
+		{
+			name: "valid",
+			cfg:  Config{Profiles: map[string]Profile{DefaultProfile: jakarta}},
+		},
+		{
+			name: "empty",
+			cfg:  Config{},
+		},
+		{
+			// Pontianak lies almost on the equator, and 0,0 is a valid location
+			name: "equator",
+			cfg: Config{Profiles: map[string]Profile{
+				"pontianak": {Timezone: "Asia/Pontianak", Latitude: 0, Longitude: 109.3},
+				"null":      {Timezone: "UTC"},
+			}},
+		},
+		{
+			name: "top-level location",
+			cfg:  Config{Timezone: "Asia/Jakarta", Latitude: 0, Longitude: 0, Method: "auto"},
+		},
+		{
+			name: "profile fields",
+			cfg: Config{Profiles: map[string]Profile{"kantor": {
+				Timezone:    "Asia/Jakrta",
+				Latitude:    91,
+				Longitude:   math.NaN(),
+				Method:      "Kemenang",
+				Adjustments: salat.Adjustments{Subuh: 120},
+			}}},
+			want: []string{
+				"profiles.kantor.latitude",
+				"profiles.kantor.longitude",
+				"profiles.kantor.timezone",
+				"profiles.kantor.method",
+				"profiles.kantor.adjustments.subuh",
+			},
+		},
+		{
+			name: "missing timezone and bad profile name",
+			cfg:  Config{Profiles: map[string]Profile{"Kantor": {Latitude: -6.2}}},
+			want: []string{"profiles.Kantor", "profiles.Kantor.timezone"},
+		},
+		{
+			name: "global settings",
+			cfg: Config{
+				Version:           CurrentVersion + 1,
+				Language:          "fr",
+				ActiveProfile:     "rumah",
+				GeocodingAPI:      "bing",
+				GeocodingURL:      "ftp://example.com",
+				GeocodingCacheTTL: "sebulan",
+				SMTP:              SMTPConfig{Port: 70000},
+				Digest:            DigestConfig{Recipients: []string{"a@example.com", "b"}},
+				Display:           DisplayConfig{Clock: "13h", Theme: "senja"},
+			},
+			want: []string{
+				"version",
+				"language",
+				"active_profile",
+				"geocoding_api",
+				"geocoding_url",
+				"geocoding_cache_ttl",
+				"smtp.port",
+				"digest.recipients[1]",
+				"display.clock",
+				"display.theme",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.ValidateAll()
+			var got []string
+			var valErr *ValidationError
+			if errors.As(err, &valErr) {
+				for _, f := range valErr.Fields {
+					got = append(got, f.Field)
+				}
+			} else if err != nil {
+				t.Fatalf("ValidateAll = %v, want a *ValidationError", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("invalid fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Package digest builds and sends the prayer schedule digest email
+package digest
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+)
+
+// Day holds the prayer times of a single date in the digest
+type Day struct {
+	Date  time.Time
+	Times salat.PrayerTimes
+}
+
+// Schedule is the content of a digest: a location and one or more days
+type Schedule struct {
+	LocationName string
+	Latitude     float64
+	Longitude    float64
+	Method       string
+	Days         []Day
+	// Lang is the language of the email, Indonesian when empty
+	Lang i18n.Lang
+}
+
+// lang returns the language of the email
+func (s *Schedule) lang() i18n.Lang {
+	if s.Lang == "" {
+		return i18n.Default
+	}
+	return s.Lang
+}
+
+// Subject returns the email subject line for the schedule
+func (s *Schedule) Subject() string {
+	l := s.lang()
+	if len(s.Days) == 0 {
+		return l.T("Jadwal Sholat")
+	}
+	first := s.Days[0].Date
+	if len(s.Days) == 1 {
+		return l.Sprintf("Jadwal Sholat %s - %s", s.LocationName, l.LongDate(first))
+	}
+	last := s.Days[len(s.Days)-1].Date
+	return l.Sprintf("Jadwal Sholat %s - %s s/d %s", s.LocationName,
+		l.ShortDate(first), l.LongDate(last))
+}
+
+// Text renders the schedule as plain text, suitable for pasting into chat
+func (s *Schedule) Text() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "🕌 %s\n", s.Subject())
+	fmt.Fprintf(&b, "📍 %s (%.6f, %.6f) • %s\n", s.LocationName, s.Latitude, s.Longitude, s.Method)
+
+	for _, day := range s.Days {
+		fmt.Fprintf(&b, "\n%s\n", s.lang().Date(day.Date))
+		for _, row := range day.Times.All() {
+			fmt.Fprintf(&b, "%s %-8s %s\n", row.Prayer.Emoji(), row.Prayer.Name(s.lang()), row.Time.Format("15:04"))
+		}
+	}
+
+	return b.String()
+}
+
+var htmlTemplate = template.Must(template.New("digest").Funcs(template.FuncMap{
+	"clock": func(t time.Time) string { return t.Format("15:04") },
+}).Parse(`<!DOCTYPE html>
+<html lang="{{.Lang}}" dir="{{.Dir}}">
+<body style="font-family: sans-serif;">
+<h2>🕌 {{.Subject}}</h2>
+<p>📍 {{.LocationName}} ({{printf "%.6f" .Latitude}}, {{printf "%.6f" .Longitude}}) • {{.Method}}</p>
+<table cellpadding="6" style="border-collapse: collapse;">
+<tr style="background: #1f6f43; color: #ffffff;">
+<th style="text-align: start;">{{.DateHeader}}</th>{{range .Names}}<th>{{.}}</th>{{end}}
+</tr>
+{{range .Days}}<tr style="border-bottom: 1px solid #dddddd;">
+<td>{{.Date}}</td>{{range .Rows}}<td align="center">{{clock .Time}}</td>{{end}}
+</tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// HTML renders the schedule as an HTML table
+func (s *Schedule) HTML() (string, error) {
+	type htmlDay struct {
+		Date string
+		Rows []salat.PrayerTime
+	}
+
+	l := s.lang()
+	data := struct {
+		*Schedule
+		Lang       i18n.Lang
+		Dir        string
+		DateHeader string
+		Names      []string
+		Days       []htmlDay
+	}{Schedule: s, Lang: l, Dir: l.Dir(), DateHeader: l.T("Tanggal")}
+
+	for _, p := range salat.Prayers {
+		data.Names = append(data.Names, p.Name(l))
+	}
+	for _, day := range s.Days {
+		data.Days = append(data.Days, htmlDay{Date: l.Date(day.Date), Rows: day.Times.All()})
+	}
+
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, data); err != nil {
+		return "", i18n.Errorf("gagal menyusun HTML digest: %v", err)
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,134 @@
+package digest
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// golden compares got with testdata/name, or rewrites it with -update
+func golden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s differs from the golden file:\n%s", name, got)
+	}
+}
+
+// loadLocation returns the named zone, skipping the test without tzdata
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("no timezone data: %v", err)
+	}
+	return loc
+}
+
+// testSchedule is a schedule of days consecutive days from date in loc
+func testSchedule(t *testing.T, loc *time.Location, date string, days int) *Schedule {
+	t.Helper()
+	day, err := time.ParseInLocation("2006-01-02", date, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(d time.Time, h, m int) time.Time {
+		return time.Date(d.Year(), d.Month(), d.Day(), h, m, 0, 0, loc)
+	}
+	s := &Schedule{
+		LocationName: "Jakarta Pusat, DKI Jakarta",
+		Latitude:     -6.1751,
+		Longitude:    106.865,
+		Method:       "Kemenag",
+	}
+	for i := 0; i < days; i++ {
+		d := day.AddDate(0, 0, i)
+		s.Days = append(s.Days, Day{Date: d, Times: salat.PrayerTimes{
+			Imsak:   at(d, 4, 29),
+			Subuh:   at(d, 4, 39),
+			Dzuhur:  at(d, 12, 4),
+			Ashar:   at(d, 15, 11),
+			Maghrib: at(d, 18, 10),
+			Isya:    at(d, 19, 19),
+		}})
+	}
+	return s
+}
+
+func TestText(t *testing.T) {
+	s := testSchedule(t, loadLocation(t, "Asia/Jakarta"), "2024-03-12", 2)
+	golden(t, "digest.txt", s.Text())
+
+	s.Lang = i18n.English
+	golden(t, "digest-en.txt", s.Text())
+}
+
+func TestHTML(t *testing.T) {
+	s := testSchedule(t, loadLocation(t, "Asia/Jakarta"), "2024-03-12", 2)
+	s.LocationName = "Kecamatan <Tanah Abang> & sekitarnya"
+	got, err := s.HTML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden(t, "digest.html", got)
+}
+
+func TestICS(t *testing.T) {
+	stamp := time.Date(2024, 3, 11, 22, 0, 0, 0, time.UTC)
+	s := testSchedule(t, loadLocation(t, "Asia/Jakarta"), "2024-03-12", 1)
+	s.LocationName = "Masjid Istiqlal, Jalan Taman Wijaya Kusuma, Pasar Baru, Sawah Besar, Jakarta Pusat"
+	got := s.ICS(stamp)
+	golden(t, "digest.ics", got)
+
+	for _, line := range strings.Split(strings.TrimSuffix(got, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line of %d octets: %q", len(line), line)
+		}
+	}
+}
+
+func TestICSDaylightSaving(t *testing.T) {
+	stamp := time.Date(2024, 3, 29, 12, 0, 0, 0, time.UTC)
+	s := testSchedule(t, loadLocation(t, "Europe/Amsterdam"), "2024-03-30", 2)
+	s.LocationName = "Amsterdam"
+	golden(t, "digest-dst.ics", s.ICS(stamp))
+}
+
+func TestFoldICSLine(t *testing.T) {
+	// Folding never splits the octets of a character
+	line := "SUMMARY:" + strings.Repeat("ص", 40)
+	folded := foldICSLine(line)
+	for _, part := range strings.Split(folded, "\r\n ") {
+		if len(part) > 75 {
+			t.Errorf("part of %d octets", len(part))
+		}
+		if !utf8.ValidString(part) {
+			t.Errorf("part %q splits a character", part)
+		}
+	}
+	if got := strings.ReplaceAll(folded, "\r\n ", ""); got != line {
+		t.Errorf("unfolded = %q, want %q", got, line)
+	}
+	if got := foldICSLine("SUMMARY:Subuh"); got != "SUMMARY:Subuh" {
+		t.Errorf("short line folded to %q", got)
+	}
+}
@@ -0,0 +1,144 @@
+package digest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// icsTimeFormat is the UTC date-time form used by iCalendar (RFC 5545)
+const icsTimeFormat = "20060102T150405Z"
+
+// icsLocalTimeFormat is the date-time form of times with a TZID
+const icsLocalTimeFormat = "20060102T150405"
+
+// ICS renders the schedule as an iCalendar file with one event per prayer
+func (s *Schedule) ICS(stamp time.Time) string {
+	var b strings.Builder
+
+	writeLine := func(line string) {
+		b.WriteString(foldICSLine(line))
+		b.WriteString("\r\n")
+	}
+
+	writeLine("BEGIN:VCALENDAR")
+	writeLine("VERSION:2.0")
+	writeLine("PRODID:-//jadwalsalat//salat digest//ID")
+	writeLine("CALSCALE:GREGORIAN")
+	writeLine("METHOD:PUBLISH")
+
+	// Times are written in the zone of the location, described by a
+	// VTIMEZONE, so calendars show them as in the rest of the digest
+	var all []time.Time
+	for _, day := range s.Days {
+		for _, row := range day.Times.All() {
+			all = append(all, row.Time)
+		}
+	}
+	local := len(all) > 0 && all[0].Location() != time.UTC
+	if local {
+		writeTimezone(writeLine, all[0], all[len(all)-1])
+	}
+	dateTime := func(name string, t time.Time) string {
+		if !local {
+			return name + ":" + t.UTC().Format(icsTimeFormat)
+		}
+		return fmt.Sprintf("%s;TZID=%s:%s", name, t.Location(), t.Format(icsLocalTimeFormat))
+	}
+
+	for _, day := range s.Days {
+		for _, row := range day.Times.All() {
+			writeLine("BEGIN:VEVENT")
+			writeLine(fmt.Sprintf("UID:%s-%s-%.4f-%.4f@jadwalsalat",
+				row.Time.Format("20060102"), row.Prayer.Key(), s.Latitude, s.Longitude))
+			writeLine("DTSTAMP:" + stamp.UTC().Format(icsTimeFormat))
+			writeLine(dateTime("DTSTART", row.Time))
+			writeLine(dateTime("DTEND", row.Time.Add(10*time.Minute)))
+			writeLine("SUMMARY:" + escapeICSText(row.Prayer.Name(s.lang())))
+			writeLine("LOCATION:" + escapeICSText(s.LocationName))
+			writeLine("TRANSP:TRANSPARENT")
+			writeLine("END:VEVENT")
+		}
+	}
+
+	writeLine("END:VCALENDAR")
+	return b.String()
+}
+
+// writeTimezone writes a VTIMEZONE for the zone of first, with an
+// observance for each offset it has between first and last
+func writeTimezone(writeLine func(string), first, last time.Time) {
+	writeLine("BEGIN:VTIMEZONE")
+	writeLine("TZID:" + first.Location().String())
+	for t := first; ; {
+		name, offset := t.Zone()
+		start, end := t.ZoneBounds()
+		from := offset
+		if start.IsZero() {
+			// The offset has not changed in the zone's history
+			start = time.Date(1970, 1, 1, 0, 0, 0, 0, t.Location())
+		} else {
+			_, from = start.Add(-time.Second).Zone()
+		}
+
+		kind := "STANDARD"
+		if t.IsDST() {
+			kind = "DAYLIGHT"
+		}
+		writeLine("BEGIN:" + kind)
+		// The onset is in local time before the change
+		writeLine("DTSTART:" + start.In(time.FixedZone(name, from)).Format(icsLocalTimeFormat))
+		writeLine("TZOFFSETFROM:" + icsOffset(from))
+		writeLine("TZOFFSETTO:" + icsOffset(offset))
+		writeLine("TZNAME:" + name)
+		writeLine("END:" + kind)
+
+		if end.IsZero() || end.After(last) {
+			break
+		}
+		t = end
+	}
+	writeLine("END:VTIMEZONE")
+}
+
+// icsOffset formats a UTC offset in seconds as iCalendar does, e.g. +0700
+func icsOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	return fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset/60%60)
+}
+
+// escapeICSText escapes characters that have a meaning in iCalendar text values
+func escapeICSText(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+	)
+	return replacer.Replace(s)
+}
+
+// foldICSLine splits lines longer than 75 octets as required by RFC 5545
+func foldICSLine(line string) string {
+	const limit = 75
+	if len(line) <= limit {
+		return line
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
@@ -0,0 +1,201 @@
+package digest
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"jadwalsalat/config"
+	"jadwalsalat/i18n"
+)
+
+// Compose builds a MIME message with text and HTML bodies and an .ics attachment
+func Compose(from string, to []string, s *Schedule, now time.Time) ([]byte, error) {
+	htmlBody, err := s.HTML()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	mixed := multipart.NewWriter(&buf)
+
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", s.Subject()))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n\r\n", mixed.Boundary())
+
+	// Text and HTML alternatives
+	altHeader := textproto.MIMEHeader{}
+	var altBuf bytes.Buffer
+	alt := multipart.NewWriter(&altBuf)
+	altHeader.Set("Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", alt.Boundary()))
+	altPart, err := mixed.CreatePart(altHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeQuotedPrintable(alt, "text/plain; charset=utf-8", s.Text()); err != nil {
+		return nil, err
+	}
+	if err := writeQuotedPrintable(alt, "text/html; charset=utf-8", htmlBody); err != nil {
+		return nil, err
+	}
+	if err := alt.Close(); err != nil {
+		return nil, err
+	}
+	if _, err := altPart.Write(altBuf.Bytes()); err != nil {
+		return nil, err
+	}
+
+	// Calendar attachment
+	icsHeader := textproto.MIMEHeader{}
+	icsHeader.Set("Content-Type", `text/calendar; charset=utf-8; method=PUBLISH; name="jadwal-sholat.ics"`)
+	icsHeader.Set("Content-Transfer-Encoding", "base64")
+	icsHeader.Set("Content-Disposition", `attachment; filename="jadwal-sholat.ics"`)
+	icsPart, err := mixed.CreatePart(icsHeader)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := icsPart.Write(wrapBase64([]byte(s.ICS(now)))); err != nil {
+		return nil, err
+	}
+
+	if err := mixed.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeQuotedPrintable adds a quoted-printable encoded part to w
+func writeQuotedPrintable(w *multipart.Writer, contentType, body string) error {
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType)
+	header.Set("Content-Transfer-Encoding", "quoted-printable")
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// wrapBase64 encodes data as base64 with 76-character lines
+func wrapBase64(data []byte) []byte {
+	encoded := base64.StdEncoding.EncodeToString(data)
+
+	var buf bytes.Buffer
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76])
+		buf.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded)
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
+
+// Send delivers msg through the configured SMTP server.
+// Port 465 uses implicit TLS; other ports upgrade with STARTTLS when the server offers it.
+func Send(cfg config.SMTPConfig, to []string, msg []byte) error {
+	if cfg.Host == "" {
+		return i18n.Errorf("smtp.host belum dikonfigurasi")
+	}
+	if cfg.From == "" {
+		return i18n.Errorf("smtp.from belum dikonfigurasi")
+	}
+	if len(to) == 0 {
+		return i18n.Errorf("tidak ada penerima digest")
+	}
+
+	port := cfg.Port
+	if port == 0 {
+		port = 587
+	}
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(port))
+	tlsConfig := &tls.Config{ServerName: cfg.Host}
+
+	var client *smtp.Client
+	if port == 465 {
+		conn, err := tls.DialWithDialer(&net.Dialer{Timeout: 30 * time.Second}, "tcp", addr, tlsConfig)
+		if err != nil {
+			return i18n.Errorf("tidak dapat terhubung ke server SMTP: %v", err)
+		}
+		client, err = smtp.NewClient(conn, cfg.Host)
+		if err != nil {
+			conn.Close()
+			return i18n.Errorf("tidak dapat memulai sesi SMTP: %v", err)
+		}
+	} else {
+		conn, err := net.DialTimeout("tcp", addr, 30*time.Second)
+		if err != nil {
+			return i18n.Errorf("tidak dapat terhubung ke server SMTP: %v", err)
+		}
+		client, err = smtp.NewClient(conn, cfg.Host)
+		if err != nil {
+			conn.Close()
+			return i18n.Errorf("tidak dapat memulai sesi SMTP: %v", err)
+		}
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(tlsConfig); err != nil {
+				client.Close()
+				return i18n.Errorf("STARTTLS gagal: %v", err)
+			}
+		}
+	}
+	defer client.Close()
+
+	if cfg.Username != "" {
+		auth := smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+		if err := client.Auth(auth); err != nil {
+			return i18n.Errorf("autentikasi SMTP gagal: %v", err)
+		}
+	}
+
+	if err := client.Mail(addressOnly(cfg.From)); err != nil {
+		return i18n.Errorf("SMTP MAIL FROM ditolak: %v", err)
+	}
+	for _, rcpt := range to {
+		if err := client.Rcpt(addressOnly(rcpt)); err != nil {
+			return i18n.Errorf("SMTP RCPT TO %s ditolak: %v", rcpt, err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return i18n.Errorf("SMTP DATA gagal: %v", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return i18n.Errorf("tidak dapat menulis pesan: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return i18n.Errorf("server SMTP menolak pesan: %v", err)
+	}
+
+	return client.Quit()
+}
+
+// addressOnly strips a display name such as "Masjid <admin@example.org>"
+func addressOnly(addr string) string {
+	if parsed, err := mail.ParseAddress(addr); err == nil {
+		return parsed.Address
+	}
+	return strings.TrimSpace(addr)
+}
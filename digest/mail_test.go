@@ -0,0 +1,107 @@
+package digest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompose(t *testing.T) {
+	s := testSchedule(t, loadLocation(t, "Asia/Jakarta"), "2024-03-12", 1)
+	now := time.Date(2024, 3, 12, 4, 0, 0, 0, s.Days[0].Date.Location())
+	msg, err := Compose("Masjid <admin@example.org>", []string{"a@example.org", "b@example.org"}, s, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	if err != nil || subject != s.Subject() {
+		t.Errorf("Subject = %q, %v; want %q", subject, err, s.Subject())
+	}
+	if got := m.Header.Get("To"); got != "a@example.org, b@example.org" {
+		t.Errorf("To = %q", got)
+	}
+
+	parts := readParts(t, m.Header.Get("Content-Type"), m.Body)
+	if len(parts) != 2 {
+		t.Fatalf("%d parts, want the alternatives and the .ics", len(parts))
+	}
+	alt := readParts(t, parts[0].header.Get("Content-Type"), bytes.NewReader(parts[0].body))
+	if len(alt) != 2 {
+		t.Fatalf("%d alternatives, want text and HTML", len(alt))
+	}
+	if got := decodeQuotedPrintable(t, alt[0].body); got != s.Text() {
+		t.Errorf("text body = %q, want %q", got, s.Text())
+	}
+	html, _ := s.HTML()
+	if got := decodeQuotedPrintable(t, alt[1].body); got != html {
+		t.Errorf("HTML body = %q, want %q", got, html)
+	}
+
+	ics, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(string(parts[1].body), "\r\n", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(ics) != s.ICS(now) {
+		t.Errorf("attachment = %q, want the schedule's .ics", ics)
+	}
+	for _, line := range strings.Split(string(parts[1].body), "\r\n") {
+		if len(line) > 76 {
+			t.Errorf("base64 line of %d characters", len(line))
+		}
+	}
+}
+
+// part is a part of a multipart body
+type part struct {
+	header textproto.MIMEHeader
+	body   []byte
+}
+
+// readParts splits a multipart body with the given content type
+func readParts(t *testing.T, contentType string, body io.Reader) []part {
+	t.Helper()
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parts []part
+	r := multipart.NewReader(body, params["boundary"])
+	for {
+		p, err := r.NextRawPart()
+		if err == io.EOF {
+			return parts
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts = append(parts, part{p.Header, data})
+	}
+}
+
+// decodeQuotedPrintable decodes a quoted-printable body, with the line
+// breaks of its text back to "\n"
+func decodeQuotedPrintable(t *testing.T, body []byte) string {
+	t.Helper()
+	data, err := io.ReadAll(quotedprintable.NewReader(bytes.NewReader(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.ReplaceAll(string(data), "\r\n", "\n")
+}
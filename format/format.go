@@ -0,0 +1,227 @@
+// Package format renders prayer times through user-defined text/template
+// layouts, for status bars, prompts, and scripts
+package format
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+)
+
+// Data is the data model of a template, documented in the README
+type Data struct {
+	// Now is the time the data was taken, in the location's timezone
+	Now time.Time
+	// Date is today's date in the output language, e.g. "Senin, 11 Maret 2024"
+	Date string
+	// Pasaran is the day of the Javanese five-day week, e.g. "Legi"
+	Pasaran  string
+	Location Location
+	// Times holds today's prayers by name, e.g. .Times.Subuh
+	Times Times
+	// Prayers lists today's prayers in order
+	Prayers []Prayer
+	// Current is the prayer whose time is running, nil before Imsak
+	Current *Prayer
+	// Next is the upcoming prayer, tomorrow's Imsak after Isya
+	Next Prayer
+	// Progress is the percentage of the time from the previous prayer (or
+	// midnight) to the next that has passed, from 0 to 100
+	Progress float64
+	Hijri    Hijri
+
+	lang  i18n.Lang
+	clock i18n.ClockFormat
+}
+
+// Location describes where the times are calculated
+type Location struct {
+	Name      string
+	Latitude  float64
+	Longitude float64
+	Timezone  string
+	Method    string
+}
+
+// Times holds the prayers of a day by name
+type Times struct {
+	Imsak, Subuh, Dzuhur, Ashar, Maghrib, Isya Prayer
+}
+
+// Prayer is a prayer as seen from Data.Now
+type Prayer struct {
+	// Name is the name in the output language, e.g. "Subuh"
+	Name string
+	// Label is Name marked when the prayer is tomorrow, e.g. "Imsak (besok)"
+	Label string
+	// Key is the lowercase Indonesian name, e.g. "subuh"
+	Key   string
+	Emoji string
+	Time  time.Time
+	// Clock is the time in the configured clock format, e.g. "15:04" or
+	// "3:04 PM"
+	Clock string
+	// In is the time left until the prayer, negative once it has passed
+	In       time.Duration
+	Tomorrow bool
+	Passed   bool
+}
+
+// Hijri is the Hijri date, which starts at Maghrib
+type Hijri struct {
+	Day, Month, Year int
+	// MonthName is the month in the output language, e.g. "Ramadhan"
+	MonthName string
+	// Date is the full date, e.g. "7 Jumadil Awal 1448 H"
+	Date string
+}
+
+// New builds the data of a template at now from the day's prayer times, in
+// the given language and the clock format of the current run
+func New(now time.Time, times salat.PrayerTimes, loc Location, lang i18n.Lang) *Data {
+	d := &Data{
+		Now:      now,
+		Date:     lang.Date(now),
+		Pasaran:  i18n.Pasaran(now),
+		Location: loc,
+		lang:     lang,
+		clock:    i18n.CurrentClockFormat(),
+	}
+
+	for _, pt := range times.All() {
+		d.Prayers = append(d.Prayers, d.prayer(pt))
+	}
+	d.Times = Times{
+		Imsak: d.Prayers[0], Subuh: d.Prayers[1], Dzuhur: d.Prayers[2],
+		Ashar: d.Prayers[3], Maghrib: d.Prayers[4], Isya: d.Prayers[5],
+	}
+
+	if current, ok := salat.GetCurrentPrayer(now, times); ok {
+		p := d.prayer(current)
+		d.Current = &p
+	}
+	next := salat.GetNextPrayer(now, times)
+	d.Next = d.prayer(next)
+
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if prev, ok := salat.GetPreviousPrayer(next, times); ok {
+		start = prev.Time
+	}
+	if interval := next.Time.Sub(start); interval > 0 {
+		d.Progress = min(max(100*float64(now.Sub(start))/float64(interval), 0), 100)
+	}
+
+	hijriDay := now
+	if !now.Before(times.Maghrib) {
+		hijriDay = hijriDay.AddDate(0, 0, 1)
+	}
+	h := salat.ToHijri(hijriDay)
+	month := lang.T(h.MonthName())
+	d.Hijri = Hijri{
+		Day:       h.Day,
+		Month:     h.Month,
+		Year:      h.Year,
+		MonthName: month,
+		Date:      lang.Sprintf("%d %s %d H", h.Day, month, h.Year),
+	}
+	return d
+}
+
+// DropEmoji empties the emoji of every prayer, for output without emoji
+func (d *Data) DropEmoji() {
+	for _, p := range []*Prayer{&d.Times.Imsak, &d.Times.Subuh, &d.Times.Dzuhur, &d.Times.Ashar, &d.Times.Maghrib, &d.Times.Isya, &d.Next, d.Current} {
+		if p != nil {
+			p.Emoji = ""
+		}
+	}
+	for i := range d.Prayers {
+		d.Prayers[i].Emoji = ""
+	}
+}
+
+// prayer describes pt as seen from the data's time
+func (d *Data) prayer(pt salat.PrayerTime) Prayer {
+	return Prayer{
+		Name:     pt.Prayer.Name(d.lang),
+		Label:    pt.Label(d.lang),
+		Key:      pt.Prayer.Key(),
+		Emoji:    strings.TrimSpace(pt.Prayer.Emoji()),
+		Time:     pt.Time,
+		Clock:    d.lang.Clock(pt.Time, d.clock),
+		In:       pt.Time.Sub(d.Now),
+		Tomorrow: pt.DayOffset == 1,
+		Passed:   !pt.Time.After(d.Now),
+	}
+}
+
+// funcs are the functions available to templates besides the built-in ones
+var funcs = template.FuncMap{
+	"short":   Short,
+	"long":    Long,
+	"minutes": func(d time.Duration) int { return int(d.Minutes()) },
+	"abs": func(d time.Duration) time.Duration {
+		if d < 0 {
+			return -d
+		}
+		return d
+	},
+	// clock and clock12 are replaced by Execute to use the language and
+	// clock format of the data
+	"clock":   i18n.Clock,
+	"clock12": func(t time.Time) string { return i18n.Indonesian.Clock(t, i18n.ClockFormat{Hour12: true}) },
+	"upper":   strings.ToUpper,
+	"lower":   strings.ToLower,
+	// date is replaced by Execute to use the language of the data
+	"date": i18n.Date,
+}
+
+// Parse parses a template with the functions of the package
+func Parse(name, text string) (*template.Template, error) {
+	return template.New(name).Funcs(funcs).Parse(text)
+}
+
+// Execute applies a template parsed by Parse to the data
+func Execute(w io.Writer, t *template.Template, d *Data) error {
+	t = t.Funcs(template.FuncMap{
+		"date":    d.lang.Date,
+		"clock":   func(t time.Time) string { return d.lang.Clock(t, d.clock) },
+		"clock12": func(t time.Time) string { return d.lang.Clock(t, i18n.ClockFormat{Hour12: true}) },
+	})
+	return t.Execute(w, d)
+}
+
+// Short formats a duration as "2h 05m" or "18m"
+func Short(d time.Duration) string {
+	sign, d := split(d)
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	if hours == 0 {
+		return fmt.Sprintf("%s%dm", sign, minutes)
+	}
+	return fmt.Sprintf("%s%dh %02dm", sign, hours, minutes)
+}
+
+// Long formats a duration as "2h 05m 09s" or "18m 09s"
+func Long(d time.Duration) string {
+	sign, d := split(d)
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	seconds := int(d.Seconds()) % 60
+	if hours == 0 {
+		return fmt.Sprintf("%s%dm %02ds", sign, minutes, seconds)
+	}
+	return fmt.Sprintf("%s%dh %02dm %02ds", sign, hours, minutes, seconds)
+}
+
+// split returns the sign and the magnitude of a duration
+func split(d time.Duration) (string, time.Duration) {
+	if d < 0 {
+		return "-", -d
+	}
+	return "", d
+}
@@ -0,0 +1,111 @@
+package format
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"jadwalsalat/i18n"
+	"jadwalsalat/salat"
+)
+
+// testData returns the data of 1 March 2024 in Jakarta at the given clock time
+func testData(t *testing.T, clock string, lang i18n.Lang) *Data {
+	t.Helper()
+	loc := time.FixedZone("WIB", 7*3600)
+	at := func(clock string) time.Time {
+		c, err := time.ParseInLocation("2006-01-02 15:04", "2024-03-01 "+clock, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	times := salat.PrayerTimes{
+		Imsak:   at("04:25"),
+		Subuh:   at("04:35"),
+		Dzuhur:  at("12:05"),
+		Ashar:   at("15:15"),
+		Maghrib: at("18:10"),
+		Isya:    at("19:20"),
+	}
+	return New(at(clock), times, Location{Name: "Jakarta"}, lang)
+}
+
+func render(t *testing.T, text string, d *Data) string {
+	t.Helper()
+	tmpl, err := Parse("test", text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if err := Execute(&b, tmpl, d); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestTemplates(t *testing.T) {
+	tests := []struct {
+		clock, text, want string
+		lang              i18n.Lang
+	}{
+		{"13:00", "{{.Next.Name}} {{.Next.In | short}}", "Ashar 2h 15m", i18n.Indonesian},
+		{"13:00", "{{.Current.Name}} {{.Current.In | abs | long}}", "Dzuhur 55m 00s", i18n.Indonesian},
+		{"03:00", "{{with .Current}}{{.Name}}{{else}}-{{end}}", "-", i18n.Indonesian},
+		{"21:00", "{{.Next.Label}} {{.Next.Clock}} {{.Next.Tomorrow}}", "Imsak (tomorrow) 04:25 true", i18n.English},
+		{"13:00", "{{.Times.Subuh.Clock}} {{.Times.Subuh.Passed}} {{.Times.Maghrib.Name | upper}}", "04:35 true MAGHRIB", i18n.Indonesian},
+		{"13:00", "{{range .Prayers}}{{.Key}} {{end}}", "imsak subuh dzuhur ashar maghrib isya ", i18n.Indonesian},
+		{"13:00", "{{.Now | date}}", "Friday, 1 March 2024", i18n.English},
+		{"13:00", "{{.Hijri.Date}}", "20 Sya'ban 1445 H", i18n.Indonesian},
+		{"19:00", "{{.Hijri.Day}}", "21", i18n.Indonesian},
+		{"13:00", "{{.Pasaran}} {{.Next.Time | clock12}}", "Pahing 3:15 PM", i18n.English},
+	}
+	for _, tt := range tests {
+		if got := render(t, tt.text, testData(t, tt.clock, tt.lang)); got != tt.want {
+			t.Errorf("%s at %s = %q, want %q", tt.text, tt.clock, got, tt.want)
+		}
+	}
+}
+
+func TestClockFormat(t *testing.T) {
+	i18n.SetClockFormat(i18n.ClockFormat{Hour12: true})
+	defer i18n.SetClockFormat(i18n.ClockFormat{})
+
+	d := testData(t, "13:00", i18n.English)
+	if got, want := render(t, "{{.Next.Clock}} {{.Now | clock}}", d), "3:15 PM 1:00 PM"; got != want {
+		t.Errorf("12-hour clock = %q, want %q", got, want)
+	}
+	d = testData(t, "13:00", i18n.Malay)
+	if got, want := d.Times.Subuh.Clock, "4:35 PG"; got != want {
+		t.Errorf("Subuh.Clock in Malay = %q, want %q", got, want)
+	}
+}
+
+func TestProgress(t *testing.T) {
+	// Halfway from Dzuhur to Ashar
+	if got := testData(t, "13:40", i18n.Indonesian).Progress; got != 50 {
+		t.Errorf("Progress = %v, want 50", got)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	if _, err := Parse("test", "{{.Next.Name"); err == nil {
+		t.Error("Parse of an unclosed action succeeded")
+	}
+	if _, err := Parse("test", "{{.Next.In | nosuchfunc}}"); err == nil {
+		t.Error("Parse with an unknown function succeeded")
+	}
+}
+
+func TestShortLong(t *testing.T) {
+	d := 2*time.Hour + 5*time.Minute + 9*time.Second
+	if got := Short(d); got != "2h 05m" {
+		t.Errorf("Short = %q", got)
+	}
+	if got := Long(-d); got != "-2h 05m 09s" {
+		t.Errorf("Long = %q", got)
+	}
+	if got := Short(18 * time.Minute); got != "18m" {
+		t.Errorf("Short = %q", got)
+	}
+}
@@ -0,0 +1,161 @@
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long cached geocoding results stay valid
+const DefaultCacheTTL = 30 * 24 * time.Hour
+
+// CacheFile is the name of the cache file inside the data directory
+const CacheFile = "geocode-cache.json"
+
+// coordinatePrecision is the number of decimals reverse lookups are rounded
+// to before caching, about 100 m at the equator
+const coordinatePrecision = 3
+
+// Cache wraps a Geocoder and remembers its results in a JSON file so that
+// repeated lookups of the same query or nearby coordinates are answered
+// without contacting the provider.
+type Cache struct {
+	geocoder Geocoder
+	prefix   string
+	path     string
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+// cacheEntry is a cached lookup and the time it was stored
+type cacheEntry struct {
+	Results []Result  `json:"results"`
+	Stored  time.Time `json:"stored"`
+}
+
+// NewCache caches the results of g in path. The name identifies the provider
+// so results of different providers sharing a file are kept apart; a
+// non-positive ttl uses DefaultCacheTTL.
+func NewCache(g Geocoder, name, path string, ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &Cache{
+		geocoder: g,
+		prefix:   name,
+		path:     path,
+		ttl:      ttl,
+		now:      time.Now,
+	}
+}
+
+// Search implements Geocoder
+func (c *Cache) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	key := fmt.Sprintf("%s|search|%d|%s", c.prefix, limit, strings.ToLower(strings.Join(strings.Fields(query), " ")))
+	if results, ok := c.get(key); ok {
+		return results, nil
+	}
+
+	results, err := c.geocoder.Search(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	c.put(key, results)
+	return results, nil
+}
+
+// Reverse implements Geocoder
+func (c *Cache) Reverse(ctx context.Context, lat, lon float64) (Result, error) {
+	key := fmt.Sprintf("%s|reverse|%.*f,%.*f", c.prefix, coordinatePrecision, lat, coordinatePrecision, lon)
+	if results, ok := c.get(key); ok && len(results) > 0 {
+		r := results[0]
+		r.Latitude, r.Longitude = lat, lon
+		return r, nil
+	}
+
+	r, err := c.geocoder.Reverse(ctx, lat, lon)
+	if err != nil {
+		return Result{}, err
+	}
+	c.put(key, []Result{r})
+	return r, nil
+}
+
+// get returns the unexpired entry stored under key
+func (c *Cache) get(key string) ([]Result, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.load()
+	e, ok := c.entries[key]
+	if !ok || c.now().Sub(e.Stored) > c.ttl {
+		return nil, false
+	}
+	return e.Results, true
+}
+
+// put stores results under key and writes the cache file. Write errors are
+// ignored: the cache only saves requests and must never fail a lookup.
+func (c *Cache) put(key string, results []Result) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.load()
+	now := c.now()
+	for k, e := range c.entries {
+		if now.Sub(e.Stored) > c.ttl {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cacheEntry{Results: results, Stored: now}
+	c.save()
+}
+
+// load reads the cache file on first use; a missing or corrupt file starts an empty cache
+func (c *Cache) load() {
+	if c.entries != nil {
+		return
+	}
+	c.entries = map[string]cacheEntry{}
+	if data, err := os.ReadFile(c.path); err == nil {
+		json.Unmarshal(data, &c.entries)
+	}
+}
+
+// save writes the cache file
+func (c *Cache) save() {
+	data, err := json.Marshal(c.entries)
+	if err != nil {
+		return
+	}
+	writeFileAtomic(c.path, data)
+}
+
+// writeFileAtomic replaces the file at path with data through a temporary
+// file, so concurrent runs never see a partial file
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,126 @@
+package geocode
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingGeocoder counts the lookups that reach the provider
+type countingGeocoder struct {
+	searches, reverses int
+}
+
+func (c *countingGeocoder) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	c.searches++
+	return []Result{{Latitude: -6.9, Longitude: 107.6, Name: query}}, nil
+}
+
+func (c *countingGeocoder) Reverse(ctx context.Context, lat, lon float64) (Result, error) {
+	c.reverses++
+	return Result{Latitude: lat, Longitude: lon, Name: "Bandung"}, nil
+}
+
+func TestCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), CacheFile)
+	stub := &countingGeocoder{}
+	c := NewCache(stub, "stub", path, time.Hour)
+
+	ctx := context.Background()
+	c.Search(ctx, "Bandung", 5)
+	c.Search(ctx, "  bandung ", 5)
+	if stub.searches != 1 {
+		t.Errorf("provider searched %d times; expected 1", stub.searches)
+	}
+
+	c.Reverse(ctx, -6.90001, 107.60001)
+	r, _ := c.Reverse(ctx, -6.90002, 107.60002)
+	if stub.reverses != 1 {
+		t.Errorf("provider reversed %d times; expected 1", stub.reverses)
+	}
+	if r.Latitude != -6.90002 || r.Name != "Bandung" {
+		t.Errorf("cached Reverse = %+v", r)
+	}
+
+	// A new cache on the same file answers from disk
+	again := NewCache(stub, "stub", path, time.Hour)
+	again.Search(ctx, "Bandung", 5)
+	if stub.searches != 1 {
+		t.Errorf("cache file not reused; provider searched %d times", stub.searches)
+	}
+
+	// Entries expire after the TTL
+	again.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	again.Search(ctx, "Bandung", 5)
+	if stub.searches != 2 {
+		t.Errorf("expired entry not refreshed; provider searched %d times", stub.searches)
+	}
+
+	// Other providers in the same file are kept apart
+	NewCache(stub, "other", path, time.Hour).Search(ctx, "Bandung", 5)
+	if stub.searches != 3 {
+		t.Errorf("cache shared between providers; provider searched %d times", stub.searches)
+	}
+}
+
+func TestRetryOnServerErrors(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&calls, 1) {
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			w.Write([]byte(`[{"lat":"-6.9","lon":"107.6","display_name":"Bandung"}]`))
+		}
+	}))
+	defer srv.Close()
+
+	g := NewNominatim(Options{BaseURL: srv.URL, Client: srv.Client(), MinInterval: time.Millisecond, RetryBackoff: time.Millisecond})
+	if _, err := Forward(context.Background(), g, "Bandung"); err != nil {
+		t.Fatalf("Forward error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("server called %d times; expected 3", calls)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	g := NewPhoton(Options{BaseURL: srv.URL, Client: srv.Client(), MaxRetries: 2, RetryBackoff: time.Millisecond})
+	if _, err := Forward(context.Background(), g, "Bandung"); err == nil {
+		t.Fatal("expected an error after retries")
+	}
+	if calls != 3 {
+		t.Errorf("server called %d times; expected 3", calls)
+	}
+}
+
+func TestNominatimRateLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"lat":"-6.9","lon":"107.6","display_name":"Bandung"}]`))
+	}))
+	defer srv.Close()
+
+	g := NewNominatim(Options{BaseURL: srv.URL, Client: srv.Client(), MinInterval: 100 * time.Millisecond})
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := Forward(context.Background(), g, "Bandung"); err != nil {
+			t.Fatalf("Forward error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Errorf("3 requests took %v; expected at least 200ms between them", elapsed)
+	}
+}
@@ -0,0 +1,467 @@
+package geocode
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"jadwalsalat/i18n"
+)
+
+//go:embed data/gazetteer.csv
+var embeddedGazetteer string
+
+// Place kinds used in the gazetteer
+const (
+	KindProvinsi  = "provinsi"
+	KindKabupaten = "kabupaten"
+	KindKota      = "kota"
+	KindKecamatan = "kecamatan"
+	KindCity      = "city"
+)
+
+// gazetteerHeader is the column layout of gazetteer CSV files
+var gazetteerHeader = []string{"name", "kind", "parent", "country", "lat", "lon", "aliases"}
+
+// Place is a single gazetteer entry
+type Place struct {
+	Name      string
+	Kind      string
+	Parent    string
+	Country   string
+	Latitude  float64
+	Longitude float64
+	Aliases   []string
+
+	// normalized forms used for matching
+	keys       []string
+	parentKey  string
+	countryKey string
+}
+
+// DisplayName returns the full name of the place, e.g. "Kabupaten Garut, Jawa Barat, Indonesia"
+func (p *Place) DisplayName() string {
+	name := p.Name
+	switch p.Kind {
+	case KindKabupaten:
+		name = "Kabupaten " + name
+	case KindKota:
+		name = "Kota " + name
+	case KindKecamatan:
+		name = "Kecamatan " + name
+	case KindProvinsi:
+		name = "Provinsi " + name
+	}
+
+	parts := []string{name}
+	if p.Parent != "" {
+		parts = append(parts, p.Parent)
+	}
+	if p.Country != "" {
+		parts = append(parts, p.Country)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// result converts the place into a geocoding Result
+func (p *Place) result() Result {
+	r := Result{
+		Latitude:  p.Latitude,
+		Longitude: p.Longitude,
+		Name:      p.DisplayName(),
+		Country:   p.Country,
+	}
+	switch p.Kind {
+	case KindProvinsi:
+		r.State = p.Name
+	case KindKabupaten, KindKota, KindCity:
+		r.State = p.Parent
+	}
+	return r
+}
+
+// Gazetteer is an in-memory list of places searchable without network access
+type Gazetteer struct {
+	places []*Place
+}
+
+// LoadGazetteer reads the embedded gazetteer and any extra CSV files in dir.
+// Extra files use the same columns as the embedded data and are typically
+// used for kecamatan-level data imported with `salat gazetteer import`.
+func LoadGazetteer(dir string) (*Gazetteer, error) {
+	g := &Gazetteer{}
+	if err := g.read(strings.NewReader(embeddedGazetteer), "embedded gazetteer"); err != nil {
+		return nil, err
+	}
+
+	if dir == "" {
+		return g, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.csv"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		err = g.read(f, file)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return g, nil
+}
+
+// ValidateGazetteer checks that r is a well-formed gazetteer CSV and returns the number of places
+func ValidateGazetteer(r io.Reader) (int, error) {
+	g := &Gazetteer{}
+	if err := g.read(r, "gazetteer"); err != nil {
+		return 0, err
+	}
+	return len(g.places), nil
+}
+
+// Len returns the number of places in the gazetteer
+func (g *Gazetteer) Len() int {
+	return len(g.places)
+}
+
+// read parses a gazetteer CSV and appends its places
+func (g *Gazetteer) read(r io.Reader, source string) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(gazetteerHeader)
+
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("%s: %v", source, err)
+	}
+	for i, col := range gazetteerHeader {
+		if strings.TrimSpace(strings.ToLower(header[i])) != col {
+			return i18n.Errorf("%s: kolom yang diharapkan %s", source, strings.Join(gazetteerHeader, ","))
+		}
+	}
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", source, err)
+		}
+
+		lat, err := strconv.ParseFloat(record[4], 64)
+		if err != nil || lat < -90 || lat > 90 {
+			return i18n.Errorf("%s: latitude tidak valid %q untuk %s", source, record[4], record[0])
+		}
+		lon, err := strconv.ParseFloat(record[5], 64)
+		if err != nil || lon < -180 || lon > 180 {
+			return i18n.Errorf("%s: longitude tidak valid %q untuk %s", source, record[5], record[0])
+		}
+
+		p := &Place{
+			Name:      record[0],
+			Kind:      strings.ToLower(record[1]),
+			Parent:    record[2],
+			Country:   record[3],
+			Latitude:  lat,
+			Longitude: lon,
+		}
+		if record[6] != "" {
+			p.Aliases = strings.Split(record[6], "|")
+		}
+
+		p.keys = append(p.keys, normalizeName(p.Name))
+		for _, alias := range p.Aliases {
+			p.keys = append(p.keys, normalizeName(alias))
+		}
+		p.parentKey = normalizeName(p.Parent)
+		p.countryKey = normalizeName(p.Country)
+
+		g.places = append(g.places, p)
+	}
+}
+
+// Match is a place found by Search together with its score; higher is better
+type Match struct {
+	Place *Place
+	Score float64
+}
+
+// Scoring weights for Search
+const (
+	// minMatchScore is the lowest name similarity accepted as a fuzzy match
+	minMatchScore = 0.75
+	// fuzzyPenalty is subtracted from inexact name matches
+	fuzzyPenalty = 0.1
+	// unmatchedWordsPenalty is subtracted when leading query words were ignored
+	unmatchedWordsPenalty = 0.15
+)
+
+// Search returns places matching query, best first.
+// The query may carry a kind prefix ("Kabupaten Garut", "Kota Bandung") and
+// comma-separated context ("Garut, Jawa Barat") to rank between namesakes.
+func (g *Gazetteer) Search(query string, limit int) []Match {
+	q := parseQuery(query)
+	if q.name == "" {
+		return nil
+	}
+
+	var matches []Match
+	for _, p := range g.places {
+		if score := q.score(p); score > 0 {
+			matches = append(matches, Match{Place: p, Score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// Nearest returns the populated place closest to the given coordinates
+// and its distance in kilometres
+func (g *Gazetteer) Nearest(lat, lon float64) (*Place, float64) {
+	var best *Place
+	bestDist := math.Inf(1)
+	for _, p := range g.places {
+		// Province entries sit on their capital and duplicate the city there
+		if p.Kind == KindProvinsi {
+			continue
+		}
+		d := haversine(lat, lon, p.Latitude, p.Longitude)
+		// Prefer the finer-grained entry when two share a location
+		if d < bestDist || (d == bestDist && kindRank(p.Kind) > kindRank(best.Kind)) {
+			best, bestDist = p, d
+		}
+	}
+	return best, bestDist
+}
+
+// kindRank orders kinds from coarse to fine
+func kindRank(kind string) int {
+	switch kind {
+	case KindKecamatan:
+		return 3
+	case KindKota, KindCity:
+		return 2
+	case KindKabupaten:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// gazetteerQuery is a parsed search query
+type gazetteerQuery struct {
+	name    string
+	kind    string
+	context []string
+	// tokens is the name split into words, used when no comma context is given
+	tokens []string
+}
+
+// kindPrefixes maps query prefixes to gazetteer kinds
+var kindPrefixes = []struct {
+	prefix string
+	kind   string
+}{
+	{"kabupaten ", KindKabupaten},
+	{"kab ", KindKabupaten},
+	{"regency ", KindKabupaten},
+	{"kota ", KindKota},
+	{"city of ", KindKota},
+	{"kecamatan ", KindKecamatan},
+	{"kec ", KindKecamatan},
+	{"provinsi ", KindProvinsi},
+	{"prov ", KindProvinsi},
+	{"province of ", KindProvinsi},
+}
+
+func parseQuery(query string) gazetteerQuery {
+	parts := strings.Split(query, ",")
+
+	var q gazetteerQuery
+	q.name = normalizeName(parts[0])
+	for _, kp := range kindPrefixes {
+		if strings.HasPrefix(q.name, kp.prefix) {
+			q.name = strings.TrimSpace(strings.TrimPrefix(q.name, kp.prefix))
+			q.kind = kp.kind
+			break
+		}
+	}
+	for _, part := range parts[1:] {
+		if c := normalizeName(part); c != "" {
+			q.context = append(q.context, c)
+		}
+	}
+	q.tokens = strings.Fields(q.name)
+	return q
+}
+
+// score rates how well p matches the query; 0 means no match
+func (q gazetteerQuery) score(p *Place) float64 {
+	best := q.scoreWith(p, q.name, q.context)
+
+	// Without commas, "Garut Jawa Barat" is tried as name "garut" with context "jawa barat",
+	// and "Monas Jakarta" as name "jakarta" with the unknown leading words ignored
+	if len(q.context) == 0 {
+		for i := 1; i < len(q.tokens); i++ {
+			name := strings.Join(q.tokens[:i], " ")
+			context := []string{strings.Join(q.tokens[i:], " ")}
+			if s := q.scoreWith(p, name, context); s > best {
+				best = s
+			}
+
+			tail := strings.Join(q.tokens[i:], " ")
+			if s := q.scoreWith(p, tail, nil) - unmatchedWordsPenalty; s > best && s >= minMatchScore {
+				best = s
+			}
+		}
+	}
+	return best
+}
+
+func (q gazetteerQuery) scoreWith(p *Place, name string, context []string) float64 {
+	nameScore := 0.0
+	for _, key := range p.keys {
+		if s := similarity(name, key); s > nameScore {
+			nameScore = s
+		}
+	}
+	// Typos are accepted, but never rank level with an exact name
+	if nameScore < 1 {
+		nameScore -= fuzzyPenalty
+	}
+	if nameScore < minMatchScore {
+		return 0
+	}
+
+	score := nameScore
+
+	// Kind given in the query must agree with the place
+	if q.kind != "" {
+		if q.kind == p.Kind || (q.kind == KindKota && p.Kind == KindCity) {
+			score += 0.15
+		} else {
+			score -= 0.2
+		}
+	} else {
+		// Prefer cities over regencies of the same name, e.g. Kota Bandung over Kabupaten Bandung
+		switch p.Kind {
+		case KindKota, KindCity:
+			score += 0.03
+		case KindProvinsi:
+			score += 0.02
+		case KindKabupaten:
+			score += 0.01
+		}
+	}
+
+	for _, c := range context {
+		if c == p.parentKey || c == p.countryKey ||
+			similarity(c, p.parentKey) >= 0.85 || similarity(c, p.countryKey) >= 0.85 {
+			score += 0.1
+		} else {
+			score -= 0.1
+		}
+	}
+
+	return score
+}
+
+// accentReplacer folds common Latin accents to ASCII
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// normalizeName lowercases s, folds accents and punctuation, and collapses spaces
+func normalizeName(s string) string {
+	s = accentReplacer.Replace(strings.ToLower(s))
+
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r > 127:
+			b.WriteRune(r)
+		case r == '\'':
+			// Sana'a -> sanaa
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// similarity returns 1 for identical strings, falling towards 0 with edit distance
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	if a == "" || b == "" {
+		return 0
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+// levenshtein computes the edit distance between two rune slices
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// haversine returns the great-circle distance in kilometres
+func haversine(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadius = 6371.0
+	toRad := math.Pi / 180
+
+	dLat := (lat2 - lat1) * toRad
+	dLon := (lon2 - lon1) * toRad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*toRad)*math.Cos(lat2*toRad)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
@@ -0,0 +1,352 @@
+// Package geocode converts between place names and coordinates using pluggable providers
+package geocode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"jadwalsalat/i18n"
+)
+
+// DefaultUserAgent identifies the application to geocoding services
+const DefaultUserAgent = "jadwalsalat/1.0"
+
+// Result is a single geocoding match
+type Result struct {
+	Latitude  float64
+	Longitude float64
+	Name      string
+	// State is the province or state of the match, when known
+	State string
+	// Country is the country of the match, when known
+	Country string
+}
+
+// Label returns a short description for choosing between results,
+// e.g. "Padang — Sumatera Barat, Indonesia (-0.9471, 100.4172)"
+func (r Result) Label() string {
+	name := r.Name
+	var region []string
+	if r.State != "" || r.Country != "" {
+		// The full name usually repeats the region, so keep only its first part
+		if i := strings.Index(name, ","); i > 0 {
+			name = name[:i]
+		}
+		for _, part := range []string{r.State, r.Country} {
+			if part != "" && part != name {
+				region = append(region, part)
+			}
+		}
+	}
+
+	label := name
+	if len(region) > 0 {
+		label += " — " + strings.Join(region, ", ")
+	}
+	return fmt.Sprintf("%s (%.4f, %.4f)", label, r.Latitude, r.Longitude)
+}
+
+// Geocoder converts place names to coordinates and back
+type Geocoder interface {
+	// Search looks up places matching a name or address, best match first
+	Search(ctx context.Context, query string, limit int) ([]Result, error)
+	// Reverse looks up the place name at the given coordinates
+	Reverse(ctx context.Context, lat, lon float64) (Result, error)
+}
+
+// Forward returns the best match for query
+func Forward(ctx context.Context, g Geocoder, query string) (Result, error) {
+	results, err := g.Search(ctx, query, 1)
+	if err != nil {
+		return Result{}, err
+	}
+	if len(results) == 0 {
+		return Result{}, &NotFoundError{Query: strconv.Quote(query)}
+	}
+	return results[0], nil
+}
+
+// dedupe drops results that repeat an earlier name at nearly the same place,
+// as Nominatim returns both the boundary and the centre node of a city
+func dedupe(results []Result) []Result {
+	var unique []Result
+	for _, r := range results {
+		duplicate := false
+		for _, u := range unique {
+			if u.Name == r.Name && haversine(u.Latitude, u.Longitude, r.Latitude, r.Longitude) < 5 {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			unique = append(unique, r)
+		}
+	}
+	return unique
+}
+
+// Options configure a geocoding provider
+type Options struct {
+	// BaseURL overrides the provider's public endpoint, e.g. a self-hosted Nominatim
+	BaseURL string
+	// UserAgent is sent with every request; defaults to DefaultUserAgent
+	UserAgent string
+	// Email is the contact address some providers ask heavy users to send
+	Email string
+	// Client is the HTTP client used for requests; defaults to http.DefaultClient
+	Client *http.Client
+	// DataDir is where providers keep local data such as imported gazetteers
+	DataDir string
+	// MinInterval is the minimum time between requests to the same host;
+	// Nominatim defaults to one second as its usage policy requires
+	MinInterval time.Duration
+	// MaxRetries is how often a request answered with 429 or 5xx is retried;
+	// defaults to DefaultMaxRetries, a negative value disables retries
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry, doubled for each
+	// following one; defaults to DefaultRetryBackoff
+	RetryBackoff time.Duration
+}
+
+const (
+	// DefaultMaxRetries is the number of retries when Options.MaxRetries is unset
+	DefaultMaxRetries = 3
+	// DefaultRetryBackoff is the first retry delay when Options.RetryBackoff is unset
+	DefaultRetryBackoff = time.Second
+)
+
+// Factory creates a Geocoder from options
+type Factory func(opts Options) Geocoder
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Factory{}
+)
+
+// Register makes a provider available under name. It panics if the name is already taken.
+func Register(name string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	name = strings.ToLower(name)
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("geocode: provider %q registered twice", name))
+	}
+	registry[name] = factory
+}
+
+// New creates the provider registered under name
+func New(name string, opts Options) (Geocoder, error) {
+	registryMu.RLock()
+	factory, ok := registry[strings.ToLower(name)]
+	registryMu.RUnlock()
+
+	if !ok {
+		return nil, i18n.Errorf("penyedia geocoding tidak dikenal %q (tersedia: %s)", name, strings.Join(Providers(), ", "))
+	}
+	return factory(opts), nil
+}
+
+// Providers returns the names of all registered providers, sorted
+func Providers() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NotFoundError is returned when a provider has no match for the query
+type NotFoundError struct {
+	Query string
+}
+
+func (e *NotFoundError) Error() string {
+	return i18n.Sprintf("tidak ada hasil untuk %s", e.Query)
+}
+
+// withDefaults fills in unset options
+func (o Options) withDefaults(baseURL string) Options {
+	if o.BaseURL == "" {
+		o.BaseURL = baseURL
+	}
+	o.BaseURL = strings.TrimRight(o.BaseURL, "/")
+	if o.UserAgent == "" {
+		o.UserAgent = DefaultUserAgent
+	}
+	if o.Email != "" && !strings.Contains(o.UserAgent, o.Email) {
+		o.UserAgent = fmt.Sprintf("%s (%s)", o.UserAgent, o.Email)
+	}
+	if o.Client == nil {
+		o.Client = http.DefaultClient
+	}
+	if o.MaxRetries == 0 {
+		o.MaxRetries = DefaultMaxRetries
+	}
+	if o.RetryBackoff <= 0 {
+		o.RetryBackoff = DefaultRetryBackoff
+	}
+	return o
+}
+
+// RequestsFile is the name of the file inside the data directory that keeps
+// the time of the latest request to each host, so the minimum interval also
+// holds between separate runs
+const RequestsFile = "geocode-requests.json"
+
+// hostLimiter spaces out requests to a single host
+type hostLimiter struct {
+	mu   sync.Mutex
+	next time.Time
+}
+
+var (
+	limitersMu sync.Mutex
+	limiters   = map[string]*hostLimiter{}
+)
+
+// waitTurn blocks until a request to the host of apiURL may be sent
+// without breaking the minimum interval between requests. With a dataDir
+// the requests of earlier runs are taken into account as well; two runs
+// starting at the same moment may still both go first.
+func waitTurn(ctx context.Context, apiURL string, interval time.Duration, dataDir string) error {
+	if interval <= 0 {
+		return nil
+	}
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return err
+	}
+
+	limitersMu.Lock()
+	l, ok := limiters[u.Host]
+	if !ok {
+		l = &hostLimiter{}
+		limiters[u.Host] = l
+	}
+	limitersMu.Unlock()
+
+	l.mu.Lock()
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	if dataDir != "" {
+		start = reserveTurn(filepath.Join(dataDir, RequestsFile), u.Host, start, interval)
+	}
+	l.next = start.Add(interval)
+	l.mu.Unlock()
+
+	return sleep(ctx, start.Sub(now))
+}
+
+// reserveTurn records a request to host in the requests file at path, no
+// earlier than start and at least interval after the latest one recorded,
+// and returns its time. Errors are ignored, leaving only the limit within
+// this run.
+func reserveTurn(path, host string, start time.Time, interval time.Duration) time.Time {
+	requests := map[string]time.Time{}
+	if data, err := os.ReadFile(path); err == nil {
+		json.Unmarshal(data, &requests)
+	}
+	// A time far ahead comes from a clock that was set back since
+	if next := requests[host].Add(interval); next.After(start) && next.Sub(start) <= interval+time.Minute {
+		start = next
+	}
+	requests[host] = start
+	if data, err := json.Marshal(requests); err == nil {
+		writeFileAtomic(path, data)
+	}
+	return start
+}
+
+// sleep waits for d or until ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retryable reports whether a response status is worth retrying
+func retryable(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+// retryAfter returns the delay requested by a Retry-After header in seconds
+func retryAfter(resp *http.Response) time.Duration {
+	secs, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || secs < 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
+
+// getJSON performs a GET request and decodes the JSON response into v.
+// Requests are rate limited per host and retried with exponential backoff
+// when the server answers 429 Too Many Requests or a 5xx error.
+func getJSON(ctx context.Context, opts Options, apiURL string, v interface{}) error {
+	backoff := opts.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		if err := waitTurn(ctx, apiURL, opts.MinInterval, opts.DataDir); err != nil {
+			return err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("User-Agent", opts.UserAgent)
+		req.Header.Set("Accept", "application/json")
+
+		resp, err := opts.Client.Do(req)
+		if err != nil {
+			return err
+		}
+
+		if retryable(resp.StatusCode) && attempt < opts.MaxRetries {
+			wait := backoff
+			if after := retryAfter(resp); after > wait {
+				wait = after
+			}
+			resp.Body.Close()
+			if err := sleep(ctx, wait); err != nil {
+				return err
+			}
+			backoff *= 2
+			continue
+		}
+
+		// Check HTTP status code
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return i18n.Errorf("API geocoding mengembalikan status %d", resp.StatusCode)
+		}
+
+		err = json.NewDecoder(resp.Body).Decode(v)
+		resp.Body.Close()
+		return err
+	}
+}
@@ -0,0 +1,122 @@
+package geocode
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type stubGeocoder struct{}
+
+func (stubGeocoder) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	return []Result{{Name: query}}, nil
+}
+
+func (stubGeocoder) Reverse(ctx context.Context, lat, lon float64) (Result, error) {
+	return Result{Latitude: lat, Longitude: lon}, nil
+}
+
+func TestRegistry(t *testing.T) {
+	Register("stub-test", func(opts Options) Geocoder { return stubGeocoder{} })
+
+	g, err := New("STUB-TEST", Options{})
+	if err != nil {
+		t.Fatalf("New(stub-test) error: %v", err)
+	}
+	r, _ := Forward(context.Background(), g, "Bandung")
+	if r.Name != "Bandung" {
+		t.Errorf("Forward returned %q; expected %q", r.Name, "Bandung")
+	}
+
+	found := map[string]bool{}
+	for _, name := range Providers() {
+		found[name] = true
+	}
+	for _, name := range []string{"nominatim", "photon", "stub-test"} {
+		if !found[name] {
+			t.Errorf("Providers() missing %q", name)
+		}
+	}
+
+	if _, err := New("does-not-exist", Options{}); err == nil {
+		t.Error("New(does-not-exist) expected error")
+	}
+}
+
+func TestRegisterTwicePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("registering nominatim twice should panic")
+		}
+	}()
+	Register("nominatim", NewNominatim)
+}
+
+func TestOptionsDefaults(t *testing.T) {
+	opts := Options{BaseURL: "https://geo.example.org/", Email: "admin@example.org"}.withDefaults(NominatimURL)
+
+	if opts.BaseURL != "https://geo.example.org" {
+		t.Errorf("BaseURL = %q; expected trailing slash trimmed", opts.BaseURL)
+	}
+	if opts.UserAgent != DefaultUserAgent+" (admin@example.org)" {
+		t.Errorf("UserAgent = %q; expected contact email appended", opts.UserAgent)
+	}
+	if opts.Client == nil {
+		t.Error("Client should default to http.DefaultClient")
+	}
+}
+
+func TestResultLabel(t *testing.T) {
+	r := Result{
+		Latitude:  -0.9471,
+		Longitude: 100.4172,
+		Name:      "Padang, Sumatera Barat, Indonesia",
+		State:     "Sumatera Barat",
+		Country:   "Indonesia",
+	}
+	if got, want := r.Label(), "Padang — Sumatera Barat, Indonesia (-0.9471, 100.4172)"; got != want {
+		t.Errorf("Label() = %q; expected %q", got, want)
+	}
+
+	r = Result{Latitude: 1, Longitude: 2, Name: "Somewhere"}
+	if got, want := r.Label(), "Somewhere (1.0000, 2.0000)"; got != want {
+		t.Errorf("Label() = %q; expected %q", got, want)
+	}
+}
+
+func TestWaitTurnAcrossRuns(t *testing.T) {
+	dir := t.TempDir()
+	const interval = 200 * time.Millisecond
+	apiURL := "https://wait-turn.example/search"
+
+	if err := waitTurn(context.Background(), apiURL, interval, dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, RequestsFile)); err != nil {
+		t.Fatalf("requests file not written: %v", err)
+	}
+
+	// A new run only knows the earlier request from the requests file
+	limitersMu.Lock()
+	delete(limiters, "wait-turn.example")
+	limitersMu.Unlock()
+
+	start := time.Now()
+	if err := waitTurn(context.Background(), apiURL, interval, dir); err != nil {
+		t.Fatal(err)
+	}
+	if waited := time.Since(start); waited < interval/2 {
+		t.Errorf("second run waited %v, want about %v", waited, interval)
+	}
+
+	// Other hosts do not wait
+	start = time.Now()
+	if err := waitTurn(context.Background(), "https://other.example/search", interval, dir); err != nil {
+		t.Fatal(err)
+	}
+	if waited := time.Since(start); waited >= interval/2 {
+		t.Errorf("another host waited %v", waited)
+	}
+}
@@ -0,0 +1,114 @@
+package geocode
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"jadwalsalat/i18n"
+)
+
+// NominatimURL is the public OpenStreetMap Nominatim endpoint
+const NominatimURL = "https://nominatim.openstreetmap.org"
+
+func init() {
+	Register("nominatim", NewNominatim)
+}
+
+// Nominatim geocodes with the OpenStreetMap Nominatim API
+type Nominatim struct {
+	opts Options
+}
+
+// nominatimInterval is the request spacing required by the Nominatim usage policy
+const nominatimInterval = time.Second
+
+// NewNominatim creates a Nominatim geocoder. Requests are spaced at least one
+// second apart unless opts.MinInterval says otherwise.
+func NewNominatim(opts Options) Geocoder {
+	if opts.MinInterval == 0 {
+		opts.MinInterval = nominatimInterval
+	}
+	return &Nominatim{opts: opts.withDefaults(NominatimURL)}
+}
+
+// params returns the query parameters shared by all Nominatim requests
+func (n *Nominatim) params() url.Values {
+	v := url.Values{}
+	v.Set("format", "json")
+	if n.opts.Email != "" {
+		v.Set("email", n.opts.Email)
+	}
+	return v
+}
+
+// nominatimPlace is a single entry of a Nominatim search response
+type nominatimPlace struct {
+	Lat         string `json:"lat"`
+	Lon         string `json:"lon"`
+	DisplayName string `json:"display_name"`
+	Address     struct {
+		State   string `json:"state"`
+		Country string `json:"country"`
+	} `json:"address"`
+}
+
+// Search implements Geocoder
+func (n *Nominatim) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	params := n.params()
+	params.Set("q", query)
+	params.Set("limit", strconv.Itoa(limit))
+	params.Set("addressdetails", "1")
+
+	var r []nominatimPlace
+	if err := getJSON(ctx, n.opts, n.opts.BaseURL+"/search?"+params.Encode(), &r); err != nil {
+		return nil, err
+	}
+	if len(r) == 0 {
+		return nil, &NotFoundError{Query: strconv.Quote(query)}
+	}
+
+	results := make([]Result, 0, len(r))
+	for _, place := range r {
+		lat, err := strconv.ParseFloat(place.Lat, 64)
+		if err != nil {
+			return nil, i18n.Errorf("latitude dari API tidak valid: %v", err)
+		}
+		lon, err := strconv.ParseFloat(place.Lon, 64)
+		if err != nil {
+			return nil, i18n.Errorf("longitude dari API tidak valid: %v", err)
+		}
+		results = append(results, Result{
+			Latitude:  lat,
+			Longitude: lon,
+			Name:      place.DisplayName,
+			State:     place.Address.State,
+			Country:   place.Address.Country,
+		})
+	}
+	return dedupe(results), nil
+}
+
+// Reverse implements Geocoder
+func (n *Nominatim) Reverse(ctx context.Context, lat, lon float64) (Result, error) {
+	params := n.params()
+	params.Set("lat", strconv.FormatFloat(lat, 'f', 6, 64))
+	params.Set("lon", strconv.FormatFloat(lon, 'f', 6, 64))
+
+	var r nominatimPlace
+	if err := getJSON(ctx, n.opts, n.opts.BaseURL+"/reverse?"+params.Encode(), &r); err != nil {
+		return Result{}, err
+	}
+	if r.DisplayName == "" {
+		return Result{}, &NotFoundError{Query: fmt.Sprintf("coordinates %f, %f", lat, lon)}
+	}
+	return Result{
+		Latitude:  lat,
+		Longitude: lon,
+		Name:      r.DisplayName,
+		State:     r.Address.State,
+		Country:   r.Address.Country,
+	}, nil
+}
@@ -0,0 +1,145 @@
+package geocode
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNominatimForward(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search" {
+			t.Errorf("path = %q; expected /search", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("q"); got != "Kota Bandung" {
+			t.Errorf("q = %q; expected %q", got, "Kota Bandung")
+		}
+		if got := r.URL.Query().Get("email"); got != "admin@example.org" {
+			t.Errorf("email = %q; expected admin@example.org", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != "salat-test (admin@example.org)" {
+			t.Errorf("User-Agent = %q", got)
+		}
+		w.Write([]byte(`[{"lat":"-6.9218","lon":"107.6071","display_name":"Kota Bandung, Jawa Barat, Indonesia"}]`))
+	}))
+	defer srv.Close()
+
+	g := NewNominatim(Options{
+		BaseURL:   srv.URL,
+		UserAgent: "salat-test",
+		Email:     "admin@example.org",
+		Client:    srv.Client(),
+	})
+
+	r, err := Forward(context.Background(), g, "Kota Bandung")
+	if err != nil {
+		t.Fatalf("Forward error: %v", err)
+	}
+	if r.Latitude != -6.9218 || r.Longitude != 107.6071 {
+		t.Errorf("coordinates = %f, %f; expected -6.9218, 107.6071", r.Latitude, r.Longitude)
+	}
+	if r.Name != "Kota Bandung, Jawa Barat, Indonesia" {
+		t.Errorf("Name = %q", r.Name)
+	}
+}
+
+func TestNominatimForwardNoResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[]`))
+	}))
+	defer srv.Close()
+
+	g := NewNominatim(Options{BaseURL: srv.URL, Client: srv.Client()})
+	_, err := Forward(context.Background(), g, "Atlantis")
+
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected NotFoundError; got %v", err)
+	}
+}
+
+func TestNominatimForwardBadCoordinates(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"lat":"north","lon":"107.6","display_name":"X"}]`))
+	}))
+	defer srv.Close()
+
+	g := NewNominatim(Options{BaseURL: srv.URL, Client: srv.Client()})
+	if _, err := Forward(context.Background(), g, "X"); err == nil {
+		t.Error("expected error for invalid latitude")
+	}
+}
+
+func TestNominatimSearchCandidates(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("limit"); got != "5" {
+			t.Errorf("limit = %q; expected 5", got)
+		}
+		if got := r.URL.Query().Get("addressdetails"); got != "1" {
+			t.Errorf("addressdetails = %q; expected 1", got)
+		}
+		w.Write([]byte(`[
+			{"lat":"-0.9471","lon":"100.4172","display_name":"Padang, Sumatera Barat, Indonesia","address":{"state":"Sumatera Barat","country":"Indonesia"}},
+			{"lat":"-0.9500","lon":"100.4200","display_name":"Padang, Sumatera Barat, Indonesia","address":{"state":"Sumatera Barat","country":"Indonesia"}},
+			{"lat":"-6.3000","lon":"106.1000","display_name":"Padang, Banten, Indonesia","address":{"state":"Banten","country":"Indonesia"}}
+		]`))
+	}))
+	defer srv.Close()
+
+	g := NewNominatim(Options{BaseURL: srv.URL, Client: srv.Client()})
+	results, err := g.Search(context.Background(), "Padang", 5)
+	if err != nil {
+		t.Fatalf("Search error: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results; expected 2 after dropping the duplicate", len(results))
+	}
+	if results[0].State != "Sumatera Barat" || results[1].State != "Banten" {
+		t.Errorf("states = %q, %q", results[0].State, results[1].State)
+	}
+	if results[1].Country != "Indonesia" {
+		t.Errorf("Country = %q", results[1].Country)
+	}
+}
+
+func TestNominatimReverse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reverse" {
+			t.Errorf("path = %q; expected /reverse", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("lat"); got != "-6.200000" {
+			t.Errorf("lat = %q", got)
+		}
+		if got := r.URL.Query().Get("lon"); got != "106.800000" {
+			t.Errorf("lon = %q", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != DefaultUserAgent {
+			t.Errorf("User-Agent = %q; expected default", got)
+		}
+		w.Write([]byte(`{"display_name":"Gambir, Jakarta Pusat, Indonesia"}`))
+	}))
+	defer srv.Close()
+
+	g := NewNominatim(Options{BaseURL: srv.URL, Client: srv.Client()})
+	r, err := g.Reverse(context.Background(), -6.2, 106.8)
+	if err != nil {
+		t.Fatalf("Reverse error: %v", err)
+	}
+	if r.Name != "Gambir, Jakarta Pusat, Indonesia" {
+		t.Errorf("Name = %q", r.Name)
+	}
+}
+
+func TestNominatimHTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	g := NewNominatim(Options{BaseURL: srv.URL, Client: srv.Client()})
+	if _, err := g.Reverse(context.Background(), 0, 0); err == nil {
+		t.Error("expected error for HTTP 403")
+	}
+}
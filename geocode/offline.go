@@ -0,0 +1,119 @@
+package geocode
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"sync"
+)
+
+// maxReverseDistance is how far (in km) a reverse lookup may be from the nearest known place
+const maxReverseDistance = 75.0
+
+func init() {
+	Register("offline", NewOffline)
+}
+
+// Offline geocodes against the embedded gazetteer without network access
+type Offline struct {
+	opts Options
+
+	once sync.Once
+	gaz  *Gazetteer
+	err  error
+}
+
+// NewOffline creates an offline geocoder. Extra gazetteer files are read
+// from the "gazetteer" directory inside opts.DataDir.
+func NewOffline(opts Options) Geocoder {
+	return &Offline{opts: opts}
+}
+
+// GazetteerDir returns the directory holding imported gazetteer files for dataDir
+func GazetteerDir(dataDir string) string {
+	if dataDir == "" {
+		return ""
+	}
+	return filepath.Join(dataDir, "gazetteer")
+}
+
+// gazetteer loads the gazetteer on first use
+func (o *Offline) gazetteer() (*Gazetteer, error) {
+	o.once.Do(func() {
+		o.gaz, o.err = LoadGazetteer(GazetteerDir(o.opts.DataDir))
+	})
+	return o.gaz, o.err
+}
+
+// Search implements Geocoder
+func (o *Offline) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	gaz, err := o.gazetteer()
+	if err != nil {
+		return nil, err
+	}
+
+	matches := gaz.Search(query, limit)
+	if len(matches) == 0 {
+		return nil, &NotFoundError{Query: strconv.Quote(query)}
+	}
+
+	results := make([]Result, 0, len(matches))
+	for _, m := range matches {
+		results = append(results, m.Place.result())
+	}
+	return results, nil
+}
+
+// Reverse implements Geocoder
+func (o *Offline) Reverse(ctx context.Context, lat, lon float64) (Result, error) {
+	gaz, err := o.gazetteer()
+	if err != nil {
+		return Result{}, err
+	}
+
+	p, dist := gaz.Nearest(lat, lon)
+	if p == nil || dist > maxReverseDistance {
+		return Result{}, &NotFoundError{Query: fmt.Sprintf("coordinates %f, %f", lat, lon)}
+	}
+	result := p.result()
+	result.Latitude, result.Longitude = lat, lon
+	return result, nil
+}
+
+// Fallback tries each geocoder in order and returns the first successful result.
+// If all fail, the error of the first geocoder is returned.
+type Fallback []Geocoder
+
+// Search implements Geocoder
+func (f Fallback) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	var firstErr error
+	for _, g := range f {
+		results, err := g.Search(ctx, query, limit)
+		if err == nil && len(results) > 0 {
+			return results, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr == nil {
+		firstErr = &NotFoundError{Query: strconv.Quote(query)}
+	}
+	return nil, firstErr
+}
+
+// Reverse implements Geocoder
+func (f Fallback) Reverse(ctx context.Context, lat, lon float64) (Result, error) {
+	var firstErr error
+	for _, g := range f {
+		r, err := g.Reverse(ctx, lat, lon)
+		if err == nil {
+			return r, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return Result{}, firstErr
+}
@@ -0,0 +1,131 @@
+package geocode
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGazetteerSearch(t *testing.T) {
+	gaz, err := LoadGazetteer("")
+	if err != nil {
+		t.Fatalf("LoadGazetteer error: %v", err)
+	}
+
+	tests := []struct {
+		query    string
+		expected string
+	}{
+		{"Kabupaten Garut", "Kabupaten Garut, Jawa Barat, Indonesia"},
+		{"garut", "Kabupaten Garut, Jawa Barat, Indonesia"},
+		{"Bandung", "Kota Bandung, Jawa Barat, Indonesia"},
+		{"Kab. Bandung", "Kabupaten Bandung, Jawa Barat, Indonesia"},
+		{"Malang, Jawa Timur", "Kota Malang, Jawa Timur, Indonesia"},
+		{"Garut Jawa Barat", "Kabupaten Garut, Jawa Barat, Indonesia"},
+		{"Semarng", "Kota Semarang, Jawa Tengah, Indonesia"},
+		{"Jogja", "Kota Yogyakarta, DI Yogyakarta, Indonesia"},
+		{"Mecca", "Makkah, Makkah Province, Saudi Arabia"},
+	}
+
+	for _, tt := range tests {
+		matches := gaz.Search(tt.query, 1)
+		if len(matches) == 0 {
+			t.Errorf("Search(%q) found nothing; expected %q", tt.query, tt.expected)
+			continue
+		}
+		if got := matches[0].Place.DisplayName(); got != tt.expected {
+			t.Errorf("Search(%q) = %q; expected %q", tt.query, got, tt.expected)
+		}
+	}
+
+	if matches := gaz.Search("Xyzzyqwerty", 1); len(matches) != 0 {
+		t.Errorf("Search(Xyzzyqwerty) = %q; expected no match", matches[0].Place.DisplayName())
+	}
+}
+
+func TestOfflineImportedFiles(t *testing.T) {
+	dataDir := t.TempDir()
+	dir := GazetteerDir(dataDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	csv := "name,kind,parent,country,lat,lon,aliases\nTarogong Kidul,kecamatan,Garut,Indonesia,-7.2000,107.8900,Tarkid\n"
+	if err := os.WriteFile(filepath.Join(dir, "kecamatan.csv"), []byte(csv), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	g := NewOffline(Options{DataDir: dataDir})
+
+	r, err := Forward(context.Background(), g, "Kec. Tarkid")
+	if err != nil {
+		t.Fatalf("Forward error: %v", err)
+	}
+	if r.Name != "Kecamatan Tarogong Kidul, Garut, Indonesia" {
+		t.Errorf("Forward(Kec. Tarkid) = %q; expected the imported kecamatan", r.Name)
+	}
+
+	r, err = g.Reverse(context.Background(), -7.201, 107.891)
+	if err != nil {
+		t.Fatalf("Reverse error: %v", err)
+	}
+	if r.Name != "Kecamatan Tarogong Kidul, Garut, Indonesia" {
+		t.Errorf("Reverse = %q; expected the imported kecamatan", r.Name)
+	}
+}
+
+func TestValidateGazetteer(t *testing.T) {
+	if _, err := ValidateGazetteer(strings.NewReader("nama,lat\nX,1\n")); err == nil {
+		t.Error("expected error for wrong header")
+	}
+	if _, err := ValidateGazetteer(strings.NewReader("name,kind,parent,country,lat,lon,aliases\nX,kota,,,95,0,\n")); err == nil {
+		t.Error("expected error for latitude out of range")
+	}
+	n, err := ValidateGazetteer(strings.NewReader("name,kind,parent,country,lat,lon,aliases\nX,kota,,,1,2,\n"))
+	if err != nil || n != 1 {
+		t.Errorf("ValidateGazetteer = %d, %v; expected 1, nil", n, err)
+	}
+}
+
+func TestOfflineReverse(t *testing.T) {
+	g := NewOffline(Options{})
+
+	r, err := g.Reverse(context.Background(), -6.2, 106.8)
+	if err != nil {
+		t.Fatalf("Reverse error: %v", err)
+	}
+	if !strings.Contains(r.Name, "Jakarta") {
+		t.Errorf("Reverse(-6.2, 106.8) = %q; expected a Jakarta city", r.Name)
+	}
+
+	// Middle of the Indian Ocean
+	_, err = g.Reverse(context.Background(), -30, 80)
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Errorf("expected NotFoundError far from any place; got %v", err)
+	}
+}
+
+func TestFallbackToOffline(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	g := Fallback{
+		NewNominatim(Options{BaseURL: srv.URL, Client: srv.Client(), MaxRetries: -1}),
+		NewOffline(Options{}),
+	}
+
+	r, err := Forward(context.Background(), g, "Kabupaten Garut")
+	if err != nil {
+		t.Fatalf("Forward error: %v", err)
+	}
+	if r.Name != "Kabupaten Garut, Jawa Barat, Indonesia" {
+		t.Errorf("Name = %q", r.Name)
+	}
+}
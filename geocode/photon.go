@@ -0,0 +1,118 @@
+package geocode
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// PhotonURL is the public Komoot Photon endpoint
+const PhotonURL = "https://photon.komoot.io"
+
+func init() {
+	Register("photon", NewPhoton)
+}
+
+// Photon geocodes with the Komoot Photon API
+type Photon struct {
+	opts Options
+}
+
+// NewPhoton creates a Photon geocoder
+func NewPhoton(opts Options) Geocoder {
+	return &Photon{opts: opts.withDefaults(PhotonURL)}
+}
+
+// photonResponse is the GeoJSON feature collection returned by Photon
+type photonResponse struct {
+	Features []struct {
+		Geometry struct {
+			Coordinates []float64 `json:"coordinates"`
+		} `json:"geometry"`
+		Properties photonProperties `json:"properties"`
+	} `json:"features"`
+}
+
+type photonProperties struct {
+	Name        string `json:"name"`
+	City        string `json:"city"`
+	State       string `json:"state"`
+	Country     string `json:"country"`
+	DisplayName string `json:"display_name"`
+}
+
+// locationName builds a readable name from the available properties
+func (p photonProperties) locationName() string {
+	name := p.Name
+	if p.City != "" {
+		name = p.City
+	}
+	if p.State != "" && name != "" {
+		name += ", " + p.State
+	}
+	if p.Country != "" && name != "" {
+		name += ", " + p.Country
+	}
+	if name == "" {
+		name = p.DisplayName
+	}
+	return name
+}
+
+// results converts the features that carry coordinates into Results
+func (r photonResponse) results() []Result {
+	var results []Result
+	for _, f := range r.Features {
+		// Photon: [lon, lat]
+		if len(f.Geometry.Coordinates) < 2 {
+			continue
+		}
+		results = append(results, Result{
+			Latitude:  f.Geometry.Coordinates[1],
+			Longitude: f.Geometry.Coordinates[0],
+			Name:      f.Properties.locationName(),
+			State:     f.Properties.State,
+			Country:   f.Properties.Country,
+		})
+	}
+	return results
+}
+
+// Search implements Geocoder
+func (p *Photon) Search(ctx context.Context, query string, limit int) ([]Result, error) {
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("limit", strconv.Itoa(limit))
+
+	var r photonResponse
+	if err := getJSON(ctx, p.opts, p.opts.BaseURL+"/api/?"+params.Encode(), &r); err != nil {
+		return nil, err
+	}
+
+	results := r.results()
+	if len(results) == 0 {
+		return nil, &NotFoundError{Query: strconv.Quote(query)}
+	}
+	return dedupe(results), nil
+}
+
+// Reverse implements Geocoder
+func (p *Photon) Reverse(ctx context.Context, lat, lon float64) (Result, error) {
+	params := url.Values{}
+	params.Set("lat", strconv.FormatFloat(lat, 'f', 6, 64))
+	params.Set("lon", strconv.FormatFloat(lon, 'f', 6, 64))
+
+	var r photonResponse
+	if err := getJSON(ctx, p.opts, p.opts.BaseURL+"/reverse?"+params.Encode(), &r); err != nil {
+		return Result{}, err
+	}
+
+	results := r.results()
+	if len(results) == 0 || results[0].Name == "" {
+		return Result{}, &NotFoundError{Query: fmt.Sprintf("coordinates %f, %f", lat, lon)}
+	}
+	result := results[0]
+	result.Latitude, result.Longitude = lat, lon
+	return result, nil
+}
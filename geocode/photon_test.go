@@ -0,0 +1,90 @@
+package geocode
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const photonBody = `{"features":[{"geometry":{"coordinates":[110.3695,-7.7956]},
+"properties":{"name":"Yogyakarta","city":"Yogyakarta","state":"Daerah Istimewa Yogyakarta","country":"Indonesia"}}]}`
+
+func TestPhotonForward(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/" {
+			t.Errorf("path = %q; expected /api/", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("q"); got != "Jogja" {
+			t.Errorf("q = %q; expected Jogja", got)
+		}
+		w.Write([]byte(photonBody))
+	}))
+	defer srv.Close()
+
+	g := NewPhoton(Options{BaseURL: srv.URL, Client: srv.Client()})
+	r, err := Forward(context.Background(), g, "Jogja")
+	if err != nil {
+		t.Fatalf("Forward error: %v", err)
+	}
+	// Photon returns [lon, lat]
+	if r.Latitude != -7.7956 || r.Longitude != 110.3695 {
+		t.Errorf("coordinates = %f, %f; expected -7.7956, 110.3695", r.Latitude, r.Longitude)
+	}
+	if r.Name != "Yogyakarta, Daerah Istimewa Yogyakarta, Indonesia" {
+		t.Errorf("Name = %q", r.Name)
+	}
+}
+
+func TestPhotonForwardNoResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"features":[]}`))
+	}))
+	defer srv.Close()
+
+	g := NewPhoton(Options{BaseURL: srv.URL, Client: srv.Client()})
+	_, err := Forward(context.Background(), g, "Atlantis")
+
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected NotFoundError; got %v", err)
+	}
+}
+
+func TestPhotonReverse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/reverse" {
+			t.Errorf("path = %q; expected /reverse", r.URL.Path)
+		}
+		w.Write([]byte(`{"features":[{"geometry":{"coordinates":[106.8,-6.2]},
+"properties":{"display_name":"Jakarta"}}]}`))
+	}))
+	defer srv.Close()
+
+	g := NewPhoton(Options{BaseURL: srv.URL, Client: srv.Client()})
+	r, err := g.Reverse(context.Background(), -6.2, 106.8)
+	if err != nil {
+		t.Fatalf("Reverse error: %v", err)
+	}
+	if r.Name != "Jakarta" {
+		t.Errorf("Name = %q; expected display_name fallback", r.Name)
+	}
+}
+
+func TestPhotonLocationName(t *testing.T) {
+	tests := []struct {
+		props    photonProperties
+		expected string
+	}{
+		{photonProperties{Name: "Monas", City: "Jakarta", Country: "Indonesia"}, "Jakarta, Indonesia"},
+		{photonProperties{Name: "Bogor", State: "Jawa Barat"}, "Bogor, Jawa Barat"},
+		{photonProperties{State: "Bali", DisplayName: "Bali"}, "Bali"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.props.locationName(); got != tt.expected {
+			t.Errorf("locationName(%+v) = %q; expected %q", tt.props, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package geocode
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"strings"
+	"sync"
+	"time"
+
+	"jadwalsalat/i18n"
+
+	"github.com/ringsaturn/tzf"
+	tzfrellite "github.com/ringsaturn/tzf-rel-lite"
+	"github.com/ringsaturn/tzf/pb"
+	"google.golang.org/protobuf/proto"
+)
+
+// zoneTab is the tz database table of timezones per country
+//
+//go:embed data/zone.tab
+var zoneTab []byte
+
+var (
+	// The tile index answers most points quickly; the polygons are only
+	// loaded for points near a border
+	indexOnce sync.Once
+	index     tzf.F
+	indexErr  error
+
+	polygonsOnce sync.Once
+	polygons     tzf.F
+	polygonsErr  error
+
+	zoneCountriesOnce sync.Once
+	zoneCountries     map[string]string
+)
+
+// loadIndex loads the pre-computed timezone tiles
+func loadIndex() (tzf.F, error) {
+	indexOnce.Do(func() {
+		input := &pb.PreindexTimezones{}
+		if indexErr = proto.Unmarshal(tzfrellite.PreindexData, input); indexErr == nil {
+			index, indexErr = tzf.NewFuzzyFinderFromPB(input)
+		}
+	})
+	return index, indexErr
+}
+
+// loadPolygons loads the simplified timezone boundary polygons
+func loadPolygons() (tzf.F, error) {
+	polygonsOnce.Do(func() {
+		input := &pb.CompressedTimezones{}
+		if polygonsErr = proto.Unmarshal(tzfrellite.LiteCompressData, input); polygonsErr == nil {
+			polygons, polygonsErr = tzf.NewFinderFromCompressed(input)
+		}
+	})
+	return polygons, polygonsErr
+}
+
+// Timezone returns the IANA timezone at the given coordinates. It works
+// offline from simplified timezone boundary polygons embedded in the binary;
+// points at sea resolve to nautical zones such as "Etc/GMT-9".
+func Timezone(lat, lon float64) (string, error) {
+	if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return "", i18n.Errorf("koordinat di luar jangkauan: %f, %f", lat, lon)
+	}
+
+	if f, err := loadIndex(); err == nil {
+		if name := f.GetTimezoneName(lon, lat); name != "" {
+			return name, nil
+		}
+	}
+
+	f, err := loadPolygons()
+	if err != nil {
+		return "", i18n.Errorf("gagal memuat batas timezone: %v", err)
+	}
+	name := f.GetTimezoneName(lon, lat)
+	if name == "" {
+		return "", i18n.Errorf("timezone tidak ditemukan untuk %f, %f", lat, lon)
+	}
+	return name, nil
+}
+
+// Country returns the ISO 3166 code of the country at the given coordinates,
+// taken from the timezone boundaries, which follow national borders. It
+// returns an empty string at sea.
+func Country(lat, lon float64) (string, error) {
+	tz, err := Timezone(lat, lon)
+	if err != nil {
+		return "", err
+	}
+
+	zoneCountriesOnce.Do(func() {
+		zoneCountries = map[string]string{}
+		scanner := bufio.NewScanner(bytes.NewReader(zoneTab))
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, "#") {
+				continue
+			}
+			// country code, coordinates, timezone, comments
+			fields := strings.Split(line, "\t")
+			if len(fields) >= 3 {
+				zoneCountries[fields[2]] = fields[0]
+			}
+		}
+	})
+	return zoneCountries[tz], nil
+}
+
+// SameOffset reports whether two locations keep the same UTC offset around t,
+// checked now and half a year later so daylight saving differences show up.
+// Asia/Jakarta and Asia/Pontianak, for instance, are the same in practice.
+func SameOffset(a, b *time.Location, t time.Time) bool {
+	for _, at := range []time.Time{t, t.AddDate(0, 6, 0)} {
+		_, offA := at.In(a).Zone()
+		_, offB := at.In(b).Zone()
+		if offA != offB {
+			return false
+		}
+	}
+	return true
+}
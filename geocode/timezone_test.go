@@ -0,0 +1,85 @@
+package geocode
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimezone(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lon float64
+		want     string
+	}{
+		{"Jakarta", -6.2088, 106.8456, "Asia/Jakarta"},
+		{"Banjarmasin", -3.3194, 114.5908, "Asia/Makassar"},
+		{"Denpasar", -8.6705, 115.2126, "Asia/Makassar"},
+		{"Jayapura", -2.5337, 140.7181, "Asia/Jayapura"},
+		{"Ambon", -3.6954, 128.1814, "Asia/Jayapura"},
+		{"Dili", -8.5569, 125.5603, "Asia/Dili"},
+		{"Kuching", 1.5535, 110.3593, "Asia/Kuching"},
+		{"Makkah", 21.4225, 39.8262, "Asia/Riyadh"},
+		{"New York", 40.7128, -74.0060, "America/New_York"},
+	}
+	for _, tt := range tests {
+		got, err := Timezone(tt.lat, tt.lon)
+		if err != nil {
+			t.Errorf("Timezone(%s) error: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Timezone(%s) = %q; expected %q", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := Timezone(91, 0); err == nil {
+		t.Error("expected an error for an invalid latitude")
+	}
+}
+
+func TestSameOffset(t *testing.T) {
+	load := func(name string) *time.Location {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			t.Fatalf("LoadLocation(%s): %v", name, err)
+		}
+		return loc
+	}
+	now := time.Date(2025, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	if !SameOffset(load("Asia/Jakarta"), load("Asia/Pontianak"), now) {
+		t.Error("Asia/Jakarta and Asia/Pontianak should share an offset")
+	}
+	if SameOffset(load("Asia/Jakarta"), load("Asia/Jayapura"), now) {
+		t.Error("Asia/Jakarta and Asia/Jayapura should differ")
+	}
+	// Same offset in January but not in July
+	if SameOffset(load("Europe/London"), load("Africa/Abidjan"), now) {
+		t.Error("Europe/London and Africa/Abidjan differ during daylight saving")
+	}
+}
+
+func TestCountry(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lon float64
+		want     string
+	}{
+		{"Jayapura", -2.5337, 140.7181, "ID"},
+		{"Kuching", 1.5535, 110.3593, "MY"},
+		{"Singapore", 1.3521, 103.8198, "SG"},
+		{"Makkah", 21.4225, 39.8262, "SA"},
+		{"Toronto", 43.6532, -79.3832, "CA"},
+		{"Indian Ocean", -30, 80, ""},
+	}
+	for _, tt := range tests {
+		got, err := Country(tt.lat, tt.lon)
+		if err != nil {
+			t.Errorf("Country(%s) error: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Country(%s) = %q; expected %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,436 @@
+package i18n
+
+// arabic translates the Indonesian messages to Arabic
+var arabic = map[string]string{
+	// Prayer names
+	"Imsak":   "الإمساك",
+	"Subuh":   "الفجر",
+	"Dzuhur":  "الظهر",
+	"Ashar":   "العصر",
+	"Maghrib": "المغرب",
+	"Isya":    "العشاء",
+
+	// Weekdays
+	"Minggu": "الأحد",
+	"Senin":  "الاثنين",
+	"Selasa": "الثلاثاء",
+	"Rabu":   "الأربعاء",
+	"Kamis":  "الخميس",
+	"Jumat":  "الجمعة",
+	"Sabtu":  "السبت",
+	"Min":    "أحد",
+	"Sen":    "اثن",
+	"Sel":    "ثلا",
+	"Rab":    "أرب",
+	"Kam":    "خمي",
+	"Jum":    "جمع",
+	"Sab":    "سبت",
+
+	// Months
+	"Januari":   "يناير",
+	"Februari":  "فبراير",
+	"Maret":     "مارس",
+	"April":     "أبريل",
+	"Mei":       "مايو",
+	"Juni":      "يونيو",
+	"Juli":      "يوليو",
+	"Agustus":   "أغسطس",
+	"September": "سبتمبر",
+	"Oktober":   "أكتوبر",
+	"November":  "نوفمبر",
+	"Desember":  "ديسمبر",
+	"Jan":       "يناير",
+	"Feb":       "فبراير",
+	"Mar":       "مارس",
+	"Apr":       "أبريل",
+	"Jun":       "يونيو",
+	"Jul":       "يوليو",
+	"Agu":       "أغسطس",
+	"Sep":       "سبتمبر",
+	"Okt":       "أكتوبر",
+	"Nov":       "نوفمبر",
+	"Des":       "ديسمبر",
+
+	// Hijri months
+	"Muharram":      "محرم",
+	"Safar":         "صفر",
+	"Rabiul Awal":   "ربيع الأول",
+	"Rabiul Akhir":  "ربيع الآخر",
+	"Jumadil Awal":  "جمادى الأولى",
+	"Jumadil Akhir": "جمادى الآخرة",
+	"Rajab":         "رجب",
+	"Sya'ban":       "شعبان",
+	"Ramadhan":      "رمضان",
+	"Syawal":        "شوال",
+	"Dzulqa'dah":    "ذو القعدة",
+	"Dzulhijjah":    "ذو الحجة",
+
+	// Formats shared by dates and labels
+	"%s, %d %s %d":    "%s، %d %s %d",
+	"%d %s %d":        "%d %s %d",
+	"%d %s":           "%d %s",
+	"%d %s %d H":      "%d %s %d هـ",
+	"%s (besok)":      "%s (غدًا)",
+	"%s (hari ini)":   "%s (اليوم)",
+	"%.1f%% selesai":  "اكتمل %.1f%%",
+	"%dm":             "%d د",
+	"%dh %02dm":       "%d س %02d د",
+	"%dm %02ds":       "%d د %02d ث",
+	"%dh %02dm %02ds": "%d س %02d د %02d ث",
+	"Error: %v":       "خطأ: %v",
+	"Peringatan: %v":  "تحذير: %v",
+	"%v\nJalankan '%s --help' untuk melihat penggunaan.":                                           "%v\nشغّل '%s --help' لعرض طريقة الاستخدام.",
+	"perintah tidak dikenal %q untuk %q":                                                           "أمر غير معروف %q لـ %q",
+	"Mungkin maksud Anda: %s":                                                                      "هل تقصد: %s",
+	"tidak dapat membaca file konfigurasi %s: %v":                                                  "تعذّرت قراءة ملف الإعدادات %s: %v",
+	"Perbaiki dengan 'salat config edit', atau jalankan 'salat doctor' untuk pemeriksaan lengkap.": "أصلحه باستخدام 'salat config edit'، أو شغّل 'salat doctor' لفحص كامل.",
+	"Periksa nilai di file konfigurasi dan variabel lingkungan SALAT_*, atau jalankan 'salat doctor' untuk pemeriksaan lengkap.": "تحقق من القيم في ملف الإعدادات ومتغيرات البيئة SALAT_*، أو شغّل 'salat doctor' لفحص كامل.",
+	"bahasa tidak didukung: %q (pilih %s)": "لغة غير مدعومة: %q (اختر %s)",
+
+	// show, now, next, watch, world
+	"AKTIF":                              "الحالي",
+	"WAKTU":                              "الصلاة",
+	"JAM":                                "الوقت",
+	"STATUS":                             "الحالة",
+	"Berikutnya":                         "التالية",
+	"Dalam":                              "بعد",
+	"Saat ini":                           "الحالية",
+	"Jam setempat":                       "الوقت المحلي",
+	"Tanggal":                            "التاريخ",
+	"Jadwal Sholat":                      "مواقيت الصلاة",
+	"🕌 Jadwal Sholat":                    "🕌 مواقيت الصلاة",
+	"🕌 Jadwal Sholat - %s":               "🕌 مواقيت الصلاة - %s",
+	"🕌 JADWAL SHOLAT LIVE":               "🕌 مواقيت الصلاة مباشرة",
+	"🌍 Jadwal Sholat Dunia":              "🌍 مواقيت الصلاة حول العالم",
+	"Jadwal Sholat %s - %s":              "مواقيت الصلاة %s - %s",
+	"Jadwal Sholat %s - %s s/d %s":       "مواقيت الصلاة %s - من %s إلى %s",
+	"Waktu Sholat Hari Ini:":             "مواقيت صلاة اليوم:",
+	"Waktu sholat saat ini: %s%s":        "الصلاة الحالية: %s%s",
+	"Tidak ada waktu sholat saat ini":    "لا توجد صلاة حالية",
+	"Sholat berikutnya: %s%s":            "الصلاة التالية: %s%s",
+	"⏰ Sholat berikutnya: %s%s dalam %s": "⏰ الصلاة التالية: %s%s بعد %s",
+	"⏱️ Sholat berikutnya: %s%s dalam %s": "⏱️ الصلاة التالية: %s%s بعد %s",
+	"%s%s pukul %s":              "%s%s عند %s",
+	"Countdown: %s":              "العد التنازلي: %s",
+	"Waktu: %s":                  "الوقت: %s",
+	"Waktu: %s (dalam %s)":       "الوقت: %s (بعد %s)",
+	"dalam %s":                   "بعد %s",
+	"Dimulai: %s (%s yang lalu)": "بدأت: %s (منذ %s)",
+	"│ 🔔 WAKTU SHOLAT %s TELAH TIBA! │": "│ 🔔 حان وقت صلاة %s! │",
+	"Keluar dari mode watch...":         "الخروج من وضع المتابعة...",
+	"📍 Lokasi: %s":                      "📍 الموقع: %s",
+	"📍 Koordinat: %.6f, %.6f":           "📍 الإحداثيات: %.6f, %.6f",
+	"🕐 Timezone: %s":                    "🕐 المنطقة الزمنية: %s",
+	"🧮 Metode: %s":                      "🧮 طريقة الحساب: %s",
+	"Semua waktu dalam %s (%s)":         "جميع الأوقات بتوقيت %s (%s)",
+	"Lokasi Anda":                       "موقعك",
+	"Lokasi (%.4f, %.4f)":               "الموقع (%.4f, %.4f)",
+	"Lokasi (%.6f, %.6f)":               "الموقع (%.6f, %.6f)",
+	"Waktu sholat ini tidak terjadi di lokasi tersebut pada tanggal ini.": "لا يحدث وقت هذه الصلاة في هذا الموقع في هذا التاريخ.",
+	"tidak terdefinisi":                           "غير محدد",
+	"waktu sholat tidak terdefinisi":              "وقت الصلاة غير محدد",
+	"%w: %s di lintang %.4f pada %s":              "%w: %s عند خط العرض %.4f في %s",
+	"gagal menghitung jadwal sholat: %w":          "فشل حساب مواقيت الصلاة: %w",
+	"gagal menghitung jadwal sholat untuk %s: %w": "فشل حساب مواقيت الصلاة لـ %s: %w",
+	"gagal membaca file lokasi: %v":               "فشل قراءة ملف المواقع: %v",
+	"tidak ada lokasi: gunakan --location, buat file %s, atau tambahkan profil dengan 'salat profile add'": "لا توجد مواقع: استخدم --location أو أنشئ الملف %s أو أضف ملفًا شخصيًا باستخدام 'salat profile add'",
+	"timezone tidak valid %s: %v":            "منطقة زمنية غير صالحة %s: %v",
+	"timezone tidak valid: %v":               "منطقة زمنية غير صالحة: %v",
+	"timezone tidak valid: %s":               "منطقة زمنية غير صالحة: %s",
+	"timezone wajib diisi":                   "المنطقة الزمنية مطلوبة",
+	"gagal mendeteksi timezone untuk %q: %v": "فشل اكتشاف المنطقة الزمنية لـ %q: %v",
+	"gagal mendeteksi timezone: %v":          "فشل اكتشاف المنطقة الزمنية: %v",
+
+	// kiosk and tui
+	"Adzan %s":                     "أذان %s",
+	"Iqamah %s":                    "إقامة %s",
+	"--iqamah tidak boleh negatif": "يجب ألا تكون قيمة --iqamah سالبة",
+	"--iqamah-for tidak valid: %s=%d (gunakan subuh, dzuhur, ashar, maghrib, atau isya)": "قيمة --iqamah-for غير صالحة: %s=%d (استخدم subuh أو dzuhur أو ashar أو maghrib أو isya)",
+	"salat kiosk membutuhkan terminal":                                                   "يتطلب salat kiosk طرفية",
+	"salat tui membutuhkan terminal, gunakan 'salat show' untuk output biasa":            "يتطلب salat tui طرفية، استخدم 'salat show' للمخرجات العادية",
+	"gagal menjalankan kiosk: %v":                                                        "فشل تشغيل وضع العرض: %v",
+	"gagal menjalankan dashboard: %v":                                                    "فشل تشغيل لوحة المعلومات: %v",
+	"←/→ hari • [/] bulan • t hari ini • v tampilan hari/bulan • m metode • q keluar":    "←/→ يوم • [/] شهر • t اليوم • v عرض اليوم/الشهر • m الطريقة • q خروج",
+	"←→ hari • [] bulan • t • v • m • q":                                                 "←→ يوم • [] شهر • t • v • m • q",
+
+	// digest
+	"✅ Digest berhasil dikirim!":               "✅ تم إرسال الملخص!",
+	"📧 Mengirim digest ke %d penerima...":      "📧 جارٍ إرسال الملخص إلى %d من المستلمين...",
+	"tidak ada penerima digest":                "لا يوجد مستلمون للملخص",
+	"smtp.host belum dikonfigurasi":            "لم يتم ضبط smtp.host",
+	"smtp.from belum dikonfigurasi":            "لم يتم ضبط smtp.from",
+	"gagal menyusun HTML digest: %v":           "فشل إنشاء HTML الملخص: %v",
+	"gagal menyusun email: %v":                 "فشل إنشاء البريد الإلكتروني: %v",
+	"gagal mengirim email: %v":                 "فشل إرسال البريد الإلكتروني: %v",
+	"tidak dapat terhubung ke server SMTP: %v": "تعذر الاتصال بخادم SMTP: %v",
+	"tidak dapat memulai sesi SMTP: %v":        "تعذر بدء جلسة SMTP: %v",
+	"STARTTLS gagal: %v":                       "فشل STARTTLS: %v",
+	"autentikasi SMTP gagal: %v":               "فشلت مصادقة SMTP: %v",
+	"SMTP MAIL FROM ditolak: %v":               "تم رفض SMTP MAIL FROM: %v",
+	"SMTP RCPT TO %s ditolak: %v":              "تم رفض SMTP RCPT TO %s: %v",
+	"SMTP DATA gagal: %v":                      "فشل SMTP DATA: %v",
+	"tidak dapat menulis pesan: %v":            "تعذرت كتابة الرسالة: %v",
+	"server SMTP menolak pesan: %v":            "رفض خادم SMTP الرسالة: %v",
+
+	// setup and location
+	"Selamat datang di setup salat CLI!":                                                      "مرحبًا بك في إعداد salat CLI!",
+	"Konfigurasi belum lengkap. Menjalankan setup...":                                         "الإعدادات غير مكتملة. جارٍ تشغيل الإعداد...",
+	"Konfigurasi berhasil disimpan!":                                                          "تم حفظ الإعدادات!",
+	"✅ Konfigurasi berhasil disimpan!":                                                        "✅ تم حفظ الإعدادات!",
+	"Jalankan 'salat show' untuk melihat jadwal sholat hari ini.":                             "شغّل 'salat show' لعرض مواقيت صلاة اليوم.",
+	"Jalankan 'salat setup' untuk mengatur lokasi.":                                           "شغّل 'salat setup' لضبط الموقع.",
+	"🔍 Mencari koordinat lokasi...":                                                           "🔍 جارٍ البحث عن إحداثيات الموقع...",
+	"🔍 Mencari nama lokasi...":                                                                "🔍 جارٍ البحث عن اسم الموقع...",
+	"📍 Lokasi ditemukan: %s":                                                                  "📍 تم العثور على الموقع: %s",
+	"🌍 Mengatur lokasi: %s":                                                                   "🌍 ضبط الموقع: %s",
+	"Ditemukan %d lokasi untuk %q, pilih salah satu:":                                         "تم العثور على %d من المواقع لـ %q، اختر واحدًا:",
+	"lokasi %q cocok dengan %d tempat:":                                                       "الموقع %q يطابق %d من الأماكن:",
+	"gunakan nama yang lebih spesifik atau --first untuk memakai hasil teratas":               "استخدم اسمًا أدق أو --first لاستخدام النتيجة الأولى",
+	"Masukkan latitude lokasi Anda:":                                                          "أدخل خط العرض لموقعك:",
+	"Masukkan longitude lokasi Anda:":                                                         "أدخل خط الطول لموقعك:",
+	"Masukkan timezone (contoh: Asia/Jakarta):":                                               "أدخل المنطقة الزمنية (مثال: Asia/Riyadh):",
+	"Timezone lokasi: %s. Ubah?":                                                              "المنطقة الزمنية للموقع: %s. هل تريد تغييرها؟",
+	"Pilih metode perhitungan waktu sholat:":                                                  "اختر طريقة حساب مواقيت الصلاة:",
+	"gagal membaca input latitude: %v":                                                        "فشل قراءة خط العرض: %v",
+	"gagal membaca input longitude: %v":                                                       "فشل قراءة خط الطول: %v",
+	"gagal membaca input timezone: %v":                                                        "فشل قراءة المنطقة الزمنية: %v",
+	"gagal membaca konfirmasi timezone: %v":                                                   "فشل قراءة تأكيد المنطقة الزمنية: %v",
+	"gagal membaca pilihan lokasi: %v":                                                        "فشل قراءة اختيار الموقع: %v",
+	"gagal membaca pilihan metode: %v":                                                        "فشل قراءة اختيار الطريقة: %v",
+	"lokasi wajib diberikan dalam mode non-interaktif, contoh: salat setup \"Jakarta\" --yes": "الموقع مطلوب في الوضع غير التفاعلي، مثال: salat setup \"Jakarta\" --yes",
+	"Peringatan: tidak dapat menentukan nama lokasi: %v":                                      "تحذير: تعذر تحديد اسم الموقع: %v",
+	"⚠️  Peringatan: timezone %s tidak sesuai dengan lokasi (%s)":                             "⚠️  تحذير: المنطقة الزمنية %s لا تطابق الموقع (%s)",
+	"   Jalankan 'salat config set timezone %s' untuk menyesuaikan.":                          "   شغّل 'salat config set timezone %s' للتصحيح.",
+	"Lokasi diperbarui: %s":                                                                   "تم تحديث الموقع: %s",
+	"Timezone diperbarui: %s (sebelumnya %s)":                                                 "تم تحديث المنطقة الزمنية: %s (سابقًا %s)",
+	"Lokasi diatur ke: %s (%.6f, %.6f)":                                                       "تم ضبط الموقع على: %s (%.6f, %.6f)",
+	"tidak dapat menemukan lokasi %q: %w":                                                     "تعذر العثور على الموقع %q: %w",
+	"tidak dapat menemukan lokasi: %w":                                                        "تعذر العثور على الموقع: %w",
+	"gagal mencari lokasi: %w":                                                                "فشل البحث عن الموقع: %w",
+
+	// config
+	"Konfigurasi saat ini:":                            "الإعدادات الحالية:",
+	"  profil: %s":                                     "  الملف الشخصي: %s",
+	"  lokasi: %s":                                     "  الموقع: %s",
+	"Timezone diatur ke: %s":                           "تم ضبط المنطقة الزمنية على: %s",
+	"Latitude diatur ke: %.6f":                         "تم ضبط خط العرض على: %.6f",
+	"Longitude diatur ke: %.6f":                        "تم ضبط خط الطول على: %.6f",
+	"Koordinat diatur ke: %.6f, %.6f":                  "تم ضبط الإحداثيات على: %.6f, %.6f",
+	"Metode perhitungan diatur ke: %s":                 "تم ضبط طريقة الحساب على: %s",
+	"Metode perhitungan diatur ke: auto (saat ini %s)": "تم ضبط طريقة الحساب على: auto (حاليًا %s)",
+	"Geocoding API diatur ke: %s":                      "تم ضبط واجهة الترميز الجغرافي على: %s",
+	"Geocoding URL diatur ke: %s":                      "تم ضبط عنوان الترميز الجغرافي على: %s",
+	"Email kontak geocoding diatur ke: %s":             "تم ضبط بريد التواصل للترميز الجغرافي على: %s",
+	"Cache geocoding berlaku selama: %s":               "مدة ذاكرة الترميز الجغرافي: %s",
+	"Cache geocoding dinonaktifkan":                    "تم تعطيل ذاكرة الترميز الجغرافي",
+	"Penyesuaian %s diatur ke: %+d menit":              "تم ضبط تعديل %s على: %+d دقيقة",
+	"SMTP host diatur ke: %s":                          "تم ضبط خادم SMTP على: %s",
+	"SMTP port diatur ke: %d":                          "تم ضبط منفذ SMTP على: %d",
+	"SMTP username diatur ke: %s":                      "تم ضبط اسم مستخدم SMTP على: %s",
+	"SMTP password diatur":                             "تم ضبط كلمة مرور SMTP",
+	"Pengirim email diatur ke: %s":                     "تم ضبط مرسل البريد على: %s",
+	"Penerima digest diatur ke: %s":                    "تم ضبط مستلمي الملخص على: %s",
+	"API tidak valid. Pilih salah satu dari: %s":       "واجهة غير صالحة. اختر واحدة من: %s",
+	"URL geocoding tidak valid: %s":                    "عنوان ترميز جغرافي غير صالح: %s",
+	"durasi cache tidak valid (contoh: 720h, atau 0 untuk menonaktifkan): %s": "مدة ذاكرة غير صالحة (مثال: 720h، أو 0 للتعطيل): %s",
+	"penyesuaian harus berupa jumlah menit (contoh: 2 atau -3): %s":           "يجب أن يكون التعديل عددًا من الدقائق (مثال: 2 أو -3): %s",
+	"port SMTP tidak valid: %s": "منفذ SMTP غير صالح: %s",
+	"metode tidak valid: %s":    "طريقة غير صالحة: %s",
+	"metode tidak valid. Pilih salah satu dari: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto":     "طريقة غير صالحة. اختر واحدة من: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto",
+	"metode tidak valid: %s. Pilih salah satu dari: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto": "طريقة غير صالحة: %s. اختر واحدة من: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto",
+	"latitude tidak valid: %v":        "خط عرض غير صالح: %v",
+	"longitude tidak valid: %v":       "خط طول غير صالح: %v",
+	"nilai latitude tidak valid: %v":  "قيمة خط عرض غير صالحة: %v",
+	"nilai longitude tidak valid: %v": "قيمة خط طول غير صالحة: %v",
+	"koordinat tidak valid: %v":       "إحداثيات غير صالحة: %v",
+	"koordinat tidak valid: %v, %v":   "إحداثيات غير صالحة: %v, %v",
+	"koordinat di luar jangkauan: %.6f, %.6f (latitude -90..90, longitude -180..180)":             "إحداثيات خارج النطاق: %.6f, %.6f (خط العرض -90..90، خط الطول -180..180)",
+	"koordinat di luar jangkauan: %f, %f":                                                         "إحداثيات خارج النطاق: %f, %f",
+	"timezone tidak dikenal: %s (gunakan nama IANA seperti Asia/Jakarta)":                         "منطقة زمنية غير معروفة: %s (استخدم اسم IANA مثل Asia/Riyadh)",
+	"lokasi tidak bisa dikosongkan, ubah dengan 'salat config set location' atau 'salat setup'":   "لا يمكن حذف الموقع، غيّره باستخدام 'salat config set location' أو 'salat setup'",
+	"gagal menyimpan konfigurasi: %v":                                                             "فشل حفظ الإعدادات: %v",
+	"gagal membaca konfigurasi: %v":                                                               "فشل قراءة الإعدادات: %v",
+	"gagal membaca konfigurasi: %v (gunakan --replace untuk menggantinya, cadangan tetap dibuat)": "فشل قراءة الإعدادات: %v (استخدم --replace لاستبدالها، وستُحفظ نسخة احتياطية)",
+	"gagal memuat konfigurasi: %v":                                                                "فشل تحميل الإعدادات: %v",
+	"konfigurasi lokasi belum ada":                                                                "لم يتم ضبط الموقع بعد",
+	"Konfigurasi tidak valid: %v":                                                                 "إعدادات غير صالحة: %v",
+	"Perbaiki dengan 'salat config set', atau jalankan 'salat doctor' untuk pemeriksaan lengkap.": "أصلحها باستخدام 'salat config set'، أو شغّل 'salat doctor' لفحص كامل.",
+	"%s dikembalikan ke default":                                                                  "تمت إعادة %s إلى القيمة الافتراضية",
+	"berikan kunci konfigurasi atau --all":                                                        "حدد مفاتيح الإعدادات أو --all",
+	"Belum ada file konfigurasi, tidak ada yang direset.":                                         "لا يوجد ملف إعدادات بعد، لا شيء لإعادة ضبطه.",
+	"Konfigurasi direset. Cadangan: %s":                                                           "تمت إعادة ضبط الإعدادات. النسخة الاحتياطية: %s",
+	"Cadangan konfigurasi lama: %s":                                                               "نسخة احتياطية من الإعدادات القديمة: %s",
+	"Konfigurasi diekspor ke %s":                                                                  "تم تصدير الإعدادات إلى %s",
+	"Konfigurasi diimpor ke %s":                                                                   "تم استيراد الإعدادات إلى %s",
+	"Konfigurasi diperbarui ke versi %d (cadangan: %s)":                                           "تم تحديث الإعدادات إلى الإصدار %d (النسخة الاحتياطية: %s)",
+	"Tidak ada perubahan.":                                                                        "لا توجد تغييرات.",
+	"Perubahan dibatalkan, konfigurasi tidak diubah.":                                             "تم تجاهل التغييرات، لم تتغير الإعدادات.",
+	"Edit lagi? (Tidak = batalkan perubahan)":                                                     "التحرير مرة أخرى؟ (لا = تجاهل التغييرات)",
+	"⚠️  Nilai konfigurasi berikut masih tidak valid:":                                            "⚠️  قيم الإعدادات التالية ما زالت غير صالحة:",
+	"belum ada file konfigurasi di %s":                                                            "لا يوجد ملف إعدادات في %s",
+	"format tidak valid: %s (pilih yaml atau json)":                                               "تنسيق غير صالح: %s (اختر yaml أو json)",
+	"file impor tidak valid: %v":                                                                  "ملف استيراد غير صالح: %v",
+	"gagal membuka file impor: %v":                                                                "فشل فتح ملف الاستيراد: %v",
+	"gagal membuat file ekspor: %v":                                                               "فشل إنشاء ملف التصدير: %v",
+	"gagal mengekspor konfigurasi: %v":                                                            "فشل تصدير الإعدادات: %v",
+	"gagal menghapus konfigurasi: %v":                                                             "فشل حذف الإعدادات: %v",
+	"gagal menjalankan editor %s: %v":                                                             "فشل تشغيل المحرر %s: %v",
+	"gagal membaca file konfigurasi: %v":                                                          "فشل قراءة ملف الإعدادات: %v",
+	"gagal membaca file konfigurasi yang diperbarui: %v":                                          "فشل قراءة ملف الإعدادات المحدث: %v",
+	"gagal menulis file konfigurasi yang diperbarui: %v":                                          "فشلت كتابة ملف الإعدادات المحدث: %v",
+	"gagal membuat cadangan konfigurasi: %v":                                                      "فشل إنشاء نسخة احتياطية من الإعدادات: %v",
+	"gagal mencadangkan file konfigurasi: %v":                                                     "فشل إنشاء نسخة احتياطية من ملف الإعدادات: %v",
+	"gagal memperbarui konfigurasi ke versi %d: %v":                                               "فشل تحديث الإعدادات إلى الإصدار %d: %v",
+	"versi konfigurasi %d lebih baru dari yang didukung salat ini (%d), perbarui salat":           "إصدار الإعدادات %d أحدث مما يدعمه salat هذا (%d)، حدّث salat",
+	"versi konfigurasi tidak valid: %v":                                                           "إصدار إعدادات غير صالح: %v",
+	"tidak dapat membaca isi konfigurasi: %v":                                                     "تعذرت قراءة محتوى الإعدادات: %v",
+	"profiles harus berupa map, bukan %T":                                                         "يجب أن تكون profiles خريطة، وليس %T",
+	"Bahasa diatur ke: %s":                                                                        "تم ضبط اللغة على: %s",
+	"kunci konfigurasi tidak valid. Pilih salah satu dari: timezone, location, latitude, longitude, method, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, adjustments.<waktu>, smtp.host, smtp.port, smtp.username, smtp.password, smtp.from, digest.recipients, display.no_color, display.no_emoji, display.screen_reader, display.theme, display.clock, display.seconds, display.pasaran, themes.<nama>.<bagian>, formats.<nama>": "مفتاح إعدادات غير صالح. اختر واحدًا من: timezone, location, latitude, longitude, method, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, adjustments.<prayer>, smtp.host, smtp.port, smtp.username, smtp.password, smtp.from, digest.recipients, display.no_color, display.no_emoji, display.screen_reader, display.theme, display.clock, display.seconds, display.pasaran, themes.<name>.<part>, formats.<name>",
+	"kunci konfigurasi tidak valid: %s. Pilih salah satu dari: method, adjustments, adjustments.<waktu>, active_profile, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, smtp, smtp.<kunci>, digest.recipients, display, display.<kunci>, themes, themes.<nama>, themes.<nama>.<bagian>, formats, formats.<nama>":                                                                                                                     "مفتاح إعدادات غير صالح: %s. اختر واحدًا من: method, adjustments, adjustments.<prayer>, active_profile, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, smtp, smtp.<key>, digest.recipients, display, display.<key>, themes, themes.<name>, themes.<name>.<part>, formats, formats.<name>",
+
+	// validation
+	"%s: %s (nilai: %v)":                                             "%s: %s (القيمة: %v)",
+	"konfigurasi tidak valid:":                                       "إعدادات غير صالحة:",
+	"lebih baru dari yang didukung salat ini (%d)":                   "أحدث مما يدعمه salat هذا (%d)",
+	"harus salah satu dari %s":                                       "يجب أن تكون إحدى القيم %s",
+	"harus salah satu dari %s, auto":                                 "يجب أن تكون إحدى القيم %s, auto",
+	"tidak ada profil dengan nama ini":                               "لا يوجد ملف شخصي بهذا الاسم",
+	"harus berupa URL http atau https":                               "يجب أن يكون عنوان http أو https",
+	"harus berupa durasi seperti 720h, atau 0 untuk menonaktifkan":   "يجب أن تكون مدة مثل 720h، أو 0 للتعطيل",
+	"harus di antara 1 dan 65535":                                    "يجب أن تكون بين 1 و65535",
+	"harus di antara -90 dan 90":                                     "يجب أن تكون بين -90 و90",
+	"harus di antara -180 dan 180":                                   "يجب أن تكون بين -180 و180",
+	"harus di antara -%d dan %d menit":                               "يجب أن تكون بين -%d و%d دقيقة",
+	"harus berupa alamat email":                                      "يجب أن يكون عنوان بريد إلكتروني",
+	"wajib diisi":                                                    "مطلوب",
+	"timezone tidak dikenal, gunakan nama IANA seperti Asia/Jakarta": "منطقة زمنية غير معروفة، استخدم اسم IANA مثل Asia/Riyadh",
+	"gunakan huruf kecil, angka, '-' dan '_'":                        "استخدم أحرفًا صغيرة وأرقامًا و'-' و'_'",
+	"geocoding_cache_ttl tidak valid %q: gunakan durasi seperti 720h, atau 0 untuk menonaktifkan": "قيمة geocoding_cache_ttl غير صالحة %q: استخدم مدة مثل 720h، أو 0 للتعطيل",
+
+	// profiles
+	"Belum ada profil. Tambahkan dengan 'salat profile add [nama] [lokasi]'.": "لا توجد ملفات شخصية بعد. أضف واحدًا باستخدام 'salat profile add [name] [location]'.",
+	"Profil %s dihapus":                                                   "تم حذف الملف الشخصي %s",
+	"Profil aktif: %s (%s)":                                               "الملف الشخصي النشط: %s (%s)",
+	"✅ Profil %s ditambahkan: %s (%.6f, %.6f) • %s":                       "✅ تمت إضافة الملف الشخصي %s: %s (%.6f, %.6f) • %s",
+	"Jalankan 'salat profile use %s' untuk memakainya.":                   "شغّل 'salat profile use %s' لاستخدامه.",
+	"profil %q sudah ada, hapus dulu dengan 'salat profile remove %s'":    "الملف الشخصي %q موجود بالفعل، احذفه أولًا باستخدام 'salat profile remove %s'",
+	"profil %q tidak ditemukan":                                           "الملف الشخصي %q غير موجود",
+	"profil default tidak bisa dihapus, ubah dengan 'salat setup'":        "لا يمكن حذف الملف الشخصي الافتراضي، غيّره باستخدام 'salat setup'",
+	"nama profil tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'": "اسم ملف شخصي غير صالح %q: استخدم أحرفًا صغيرة وأرقامًا و'-' و'_'",
+
+	// gazetteer and geocoding
+	"✅ %d lokasi diimpor ke %s":                          "✅ تم استيراد %d من المواقع إلى %s",
+	"⬇️  Mengunduh %s...":                                "⬇️  جارٍ تنزيل %s...",
+	"server mengembalikan status %d":                     "أعاد الخادم الحالة %d",
+	"API geocoding mengembalikan status %d":              "أعادت واجهة الترميز الجغرافي الحالة %d",
+	"gagal membaca gazetteer: %v":                        "فشل قراءة المعجم الجغرافي: %v",
+	"gagal memuat gazetteer: %v":                         "فشل تحميل المعجم الجغرافي: %v",
+	"gagal menyimpan gazetteer: %v":                      "فشل حفظ المعجم الجغرافي: %v",
+	"gazetteer tidak valid: %v":                          "معجم جغرافي غير صالح: %v",
+	"%s: kolom yang diharapkan %s":                       "%s: الأعمدة المتوقعة %s",
+	"%s: latitude tidak valid %q untuk %s":               "%s: خط عرض غير صالح %q لـ %s",
+	"%s: longitude tidak valid %q untuk %s":              "%s: خط طول غير صالح %q لـ %s",
+	"lokasi %q tidak ditemukan di gazetteer (%d lokasi)": "الموقع %q غير موجود في المعجم الجغرافي (%d من المواقع)",
+	"latitude dari API tidak valid: %v":                  "خط عرض غير صالح من الواجهة: %v",
+	"longitude dari API tidak valid: %v":                 "خط طول غير صالح من الواجهة: %v",
+	"penyedia geocoding tidak dikenal %q (tersedia: %s)": "مزود ترميز جغرافي غير معروف %q (المتاح: %s)",
+	"tidak ada hasil untuk %s":                           "لا توجد نتائج لـ %s",
+	"gagal memuat batas timezone: %v":                    "فشل تحميل حدود المناطق الزمنية: %v",
+	"timezone tidak ditemukan untuk %f, %f":              "لم يتم العثور على منطقة زمنية لـ %f, %f",
+
+	// doctor
+	"🩺 Pemeriksaan salat":                         "🩺 فحص salat",
+	"%d pemeriksaan gagal, %d peringatan":         "فشل %d من الفحوصات، %d من التحذيرات",
+	"Selesai dengan %d peringatan.":               "انتهى مع %d من التحذيرات.",
+	"Semua pemeriksaan berhasil.":                 "نجحت جميع الفحوصات.",
+	"Konfigurasi":                                 "الإعدادات",
+	"Timezone":                                    "المنطقة الزمنية",
+	"Geocoding":                                   "الترميز الجغرافي",
+	"Perhitungan":                                 "الحساب",
+	"lokasi file konfigurasi tidak diketahui: %v": "موقع ملف الإعدادات غير معروف: %v",
+	"file konfigurasi %s belum ada (jalankan 'salat setup')": "ملف الإعدادات %s غير موجود (شغّل 'salat setup')",
+	"file konfigurasi %s tidak bisa dibaca: %v":              "تعذّرت قراءة ملف الإعدادات %s: %v",
+	"file konfigurasi: %s":                                   "ملف الإعدادات: %s",
+	"konfigurasi tidak bisa dibaca: %v":                      "تعذرت قراءة الإعدادات: %v",
+	"semua nilai konfigurasi valid (versi %d)":               "جميع قيم الإعدادات صالحة (الإصدار %d)",
+	"lokasi belum diatur (jalankan 'salat setup')":           "لم يتم ضبط الموقع (شغّل 'salat setup')",
+	"profil: %s":                                 "الملف الشخصي: %s",
+	"lokasi: %s (%.6f, %.6f)":                    "الموقع: %s (%.6f, %.6f)",
+	"database timezone tidak tersedia: %v":       "قاعدة بيانات المناطق الزمنية غير متاحة: %v",
+	"database timezone tersedia":                 "قاعدة بيانات المناطق الزمنية متاحة",
+	"timezone %q tidak dikenal":                  "منطقة زمنية غير معروفة %q",
+	"timezone: %s (%s)":                          "المنطقة الزمنية: %s (%s)",
+	"timezone lokasi tidak dapat ditentukan: %v": "تعذر تحديد المنطقة الزمنية للموقع: %v",
+	"timezone %s tidak sesuai dengan lokasi (%s), jalankan 'salat config set timezone %s'": "المنطقة الزمنية %s لا تطابق الموقع (%s)، شغّل 'salat config set timezone %s'",
+	"timezone sesuai dengan lokasi":                                               "المنطقة الزمنية تطابق الموقع",
+	"direktori konfigurasi tidak ditemukan: %v":                                   "لم يتم العثور على مجلد الإعدادات: %v",
+	"gazetteer offline tidak bisa dibaca: %v":                                     "تعذرت قراءة المعجم الجغرافي دون اتصال: %v",
+	"gazetteer offline: %d lokasi":                                                "المعجم الجغرافي دون اتصال: %d من المواقع",
+	"provider: offline, tidak memerlukan internet":                                "المزود: دون اتصال، لا يتطلب الإنترنت",
+	"provider %s tidak diperiksa (--offline)":                                     "لم يتم فحص المزود %s (--offline)",
+	"provider %s tidak dapat dihubungi: %v (geocoding memakai gazetteer offline)": "تعذر الوصول إلى المزود %s: %v (يستخدم الترميز الجغرافي المعجم دون اتصال)",
+	"provider %s dapat dihubungi (%d ms)":                                         "المزود %s متاح (%d ms)",
+	"  ⏭️  dilewati karena konfigurasi belum valid":                               "  ⏭️  تم التخطي لأن الإعدادات غير صالحة بعد",
+	"metode: %s":                    "الطريقة: %s",
+	"%s (%s) tidak sebelum %s (%s)": "%s (%s) ليست قبل %s (%s)",
+	"jadwal hari ini berurutan: Subuh %s, Dzuhur %s, Maghrib %s":     "مواقيت اليوم مرتبة: الفجر %s، الظهر %s، المغرب %s",
+	"Dzuhur %s menyimpang %.0f menit dari tengah hari matahari (%s)": "الظهر %s ينحرف %.0f دقيقة عن الزوال الشمسي (%s)",
+	"Dzuhur dalam %.0f menit dari tengah hari matahari":              "الظهر ضمن %.0f دقيقة من الزوال الشمسي",
+
+	// formats
+	"gagal menjalankan template: %v":                                        "فشل تنفيذ القالب: %v",
+	"nama template tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'": "اسم قالب غير صالح %q: استخدم أحرفًا صغيرة وأرقامًا و'-' و'_'",
+	"template tidak valid: %v":                                              "قالب غير صالح: %v",
+	"Template %s disimpan, pakai dengan --format %s":                        "تم حفظ القالب %s، استخدمه مع --format %s",
+
+	// status
+	"gaya tidak dikenal %q, pilih salah satu dari: %s": "نمط غير معروف %q، اختر واحدًا من: %s",
+
+	// prompt
+	"shell tidak dikenal %q, pilih salah satu dari: %s": "صدفة غير معروفة %q، اختر واحدة من: %s",
+
+	// display
+	"OK:":                                "سليم:",
+	"Peringatan:":                        "تحذير:",
+	"Gagal:":                             "فشل:",
+	"nilai harus true atau false: %s":    "يجب أن تكون القيمة true أو false: %s",
+	"%s diatur ke: %t":                   "تم تعيين %s إلى: %t",
+	"kurang dari satu menit":             "أقل من دقيقة",
+	"%d menit":                           "%d دقيقة",
+	"%d jam":                             "%d ساعة",
+	"%d jam %d menit":                    "%d ساعة و%d دقيقة",
+	"Jadwal sholat %s di %s, metode %s.": "مواقيت الصلاة ليوم %s في %s، طريقة %s.",
+	"%s pukul %s, sedang berlangsung.":   "%s الساعة %s، الوقت الحالي.",
+	"%s pukul %s, sudah lewat.":          "%s الساعة %s، انقضى.",
+	"%s pukul %s, %s lagi.":              "%s الساعة %s، بعد %s.",
+	"%s pukul %s.":                       "%s الساعة %s.",
+	"Sholat berikutnya %s pukul %s, %s lagi.":          "الصلاة القادمة %s الساعة %s، بعد %s.",
+	"Pukul %s di %s.":                                  "الساعة %s في %s.",
+	"Waktu sholat saat ini %s, dimulai pukul %s.":      "وقت الصلاة الحالي %s، بدأ الساعة %s.",
+	"Saat ini bukan waktu sholat.":                     "ليس الآن وقت صلاة.",
+	"tengah malam":                                     "منتصف الليل",
+	"%d persen waktu dari %s sampai %s sudah berlalu.": "مضى %d بالمئة من الوقت بين %s و%s.",
+	"Waktu %s telah tiba, pukul %s.":                   "حان وقت %s، الساعة %s.",
+
+	// themes
+	"warna tidak dikenal %q":                                            "لون غير معروف %q",
+	"warna atau atribut tidak dikenal %q":                               "لون أو سمة غير معروفة %q",
+	"nama tema tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'": "اسم سمة غير صالح %q: استخدم أحرفًا صغيرة وأرقامًا و'-' و'_'",
+	"tidak ada tema dengan nama %q":                                     "لا توجد سمة باسم %q",
+	"gunakan themes.<nama>.<bagian>, dengan bagian salah satu dari: %s": "استخدم themes.<name>.<part>، حيث part واحد من: %s",
+	"gagal membaca tema: %v":                                            "فشل قراءة السمات: %v",
+	"tema tidak dikenal %q, pilih salah satu dari: %s":                  "سمة غير معروفة %q، اختر واحدة من: %s",
+	"Tema diatur ke: %s":                                                "تم تعيين السمة إلى: %s",
+	"Tema %s: %s diatur ke %s, pakai dengan --theme %s":                 "السمة %s: تم تعيين %s إلى %s، استخدمها مع --theme %s",
+	"Judul": "العنوان",
+	"Lewat": "انقضى",
+
+	// clock and pasaran
+	"%s %s, %d %s %d":          "%s %s، %d %s %d",
+	"AM":                       "ص",
+	"PM":                       "م",
+	"harus 12h atau 24h":       "يجب أن تكون 12h أو 24h",
+	"Format jam diatur ke: %s": "تم تعيين تنسيق الساعة إلى: %s",
+	"format jam tidak valid %q, pilih 12h atau 24h": "تنسيق ساعة غير صالح %q، اختر 12h أو 24h",
+}
@@ -0,0 +1,65 @@
+package i18n
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// ClockFormat is how times of day are written
+type ClockFormat struct {
+	// Hour12 writes a 12-hour clock with AM/PM, e.g. "3:04 PM"
+	Hour12 bool
+	// Seconds includes the seconds, e.g. "15:04:05"
+	Seconds bool
+}
+
+// clockFormat is the clock format of the current run, see SetClockFormat
+var clockFormat ClockFormat
+
+// SetClockFormat sets the clock format used by Clock and LiveClock
+func SetClockFormat(f ClockFormat) { clockFormat = f }
+
+// CurrentClockFormat returns the clock format of the current run
+func CurrentClockFormat() ClockFormat { return clockFormat }
+
+// Clock formats the time of day of t in the language
+func (l Lang) Clock(t time.Time, f ClockFormat) string {
+	layout := "15:04"
+	if f.Hour12 {
+		layout = "3:04"
+	}
+	if f.Seconds {
+		layout += ":05"
+	}
+	s := t.Format(layout)
+	if f.Hour12 {
+		if t.Hour() < 12 {
+			return s + " " + l.T("AM")
+		}
+		return s + " " + l.T("PM")
+	}
+	return s
+}
+
+// Clock formats the time of day of t in the current language and clock
+// format, e.g. a prayer time
+func Clock(t time.Time) string { return current.Clock(t, clockFormat) }
+
+// LiveClock formats t like Clock, but always with seconds, for a clock that
+// is updated while it is shown
+func LiveClock(t time.Time) string {
+	f := clockFormat
+	f.Seconds = true
+	return current.Clock(t, f)
+}
+
+// ClockWidth returns the widest a time written by Clock can be, for aligning
+// columns of times
+func ClockWidth() int {
+	width := 0
+	for _, hour := range []int{11, 23} {
+		t := time.Date(2000, time.January, 1, hour, 59, 59, 0, time.UTC)
+		width = max(width, utf8.RuneCountInString(Clock(t)))
+	}
+	return width
+}
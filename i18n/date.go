@@ -0,0 +1,93 @@
+package i18n
+
+import "time"
+
+// Day and month names in Indonesian, translated through the catalogs
+var (
+	weekdays      = [7]string{"Minggu", "Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu"}
+	shortWeekdays = [7]string{"Min", "Sen", "Sel", "Rab", "Kam", "Jum", "Sab"}
+	months        = [12]string{"Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus", "September", "Oktober", "November", "Desember"}
+	shortMonths   = [12]string{"Jan", "Feb", "Mar", "Apr", "Mei", "Jun", "Jul", "Agu", "Sep", "Okt", "Nov", "Des"}
+)
+
+// pasarans are the days of the Javanese five-day week, the same in every
+// language; 1 January 1970 was Kamis Wage
+var pasarans = [5]string{"Legi", "Pahing", "Pon", "Wage", "Kliwon"}
+
+// pasaran is set when Date adds the pasaran to the day name, see SetPasaran
+var pasaran bool
+
+// SetPasaran sets whether Date adds the pasaran to the day name
+func SetPasaran(on bool) { pasaran = on }
+
+// Pasaran returns the day of the Javanese five-day week of t's date, e.g.
+// "Legi"
+func Pasaran(t time.Time) string {
+	days := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / (24 * 60 * 60)
+	return pasarans[((days+3)%5+5)%5]
+}
+
+// Weekday returns the name of d in the language
+func (l Lang) Weekday(d time.Weekday) string {
+	return l.T(weekdays[d])
+}
+
+// ShortWeekday returns the abbreviated name of d in the language
+func (l Lang) ShortWeekday(d time.Weekday) string {
+	return l.T(shortWeekdays[d])
+}
+
+// Month returns the name of m in the language
+func (l Lang) Month(m time.Month) string {
+	return l.T(months[m-1])
+}
+
+// ShortMonth returns the abbreviated name of m in the language
+func (l Lang) ShortMonth(m time.Month) string {
+	return l.T(shortMonths[m-1])
+}
+
+// Date formats t with its day name, e.g. "Senin, 19 Oktober 2026"
+func (l Lang) Date(t time.Time) string {
+	return l.Sprintf("%s, %d %s %d", l.Weekday(t.Weekday()), t.Day(), l.Month(t.Month()), t.Year())
+}
+
+// PasaranDate formats t with its day name and pasaran, e.g.
+// "Senin Wage, 19 Oktober 2026"
+func (l Lang) PasaranDate(t time.Time) string {
+	return l.Sprintf("%s %s, %d %s %d", l.Weekday(t.Weekday()), Pasaran(t), t.Day(), l.Month(t.Month()), t.Year())
+}
+
+// LongDate formats t without its day name, e.g. "19 Oktober 2026"
+func (l Lang) LongDate(t time.Time) string {
+	return l.Sprintf("%d %s %d", t.Day(), l.Month(t.Month()), t.Year())
+}
+
+// ShortDate formats t as e.g. "19 Okt"
+func (l Lang) ShortDate(t time.Time) string {
+	return l.Sprintf("%d %s", t.Day(), l.ShortMonth(t.Month()))
+}
+
+// Weekday returns the name of d in the current language
+func Weekday(d time.Weekday) string { return current.Weekday(d) }
+
+// ShortWeekday returns the abbreviated name of d in the current language
+func ShortWeekday(d time.Weekday) string { return current.ShortWeekday(d) }
+
+// Month returns the name of m in the current language
+func Month(m time.Month) string { return current.Month(m) }
+
+// Date formats t with its day name in the current language, and its
+// pasaran when SetPasaran is on
+func Date(t time.Time) string {
+	if pasaran {
+		return current.PasaranDate(t)
+	}
+	return current.Date(t)
+}
+
+// LongDate formats t without its day name in the current language
+func LongDate(t time.Time) string { return current.LongDate(t) }
+
+// ShortDate formats t as day and abbreviated month in the current language
+func ShortDate(t time.Time) string { return current.ShortDate(t) }
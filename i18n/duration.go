@@ -0,0 +1,31 @@
+package i18n
+
+import "time"
+
+// Duration formats a countdown in hours and minutes, e.g. "1h 05m" or "12m"
+func (l Lang) Duration(d time.Duration) string {
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	if hours == 0 {
+		return l.Sprintf("%dm", minutes)
+	}
+	return l.Sprintf("%dh %02dm", hours, minutes)
+}
+
+// LongDuration formats a countdown with seconds, e.g. "1h 05m 09s" or
+// "12m 09s"
+func (l Lang) LongDuration(d time.Duration) string {
+	hours := int(d.Hours())
+	minutes := int(d.Minutes()) % 60
+	seconds := int(d.Seconds()) % 60
+	if hours == 0 {
+		return l.Sprintf("%dm %02ds", minutes, seconds)
+	}
+	return l.Sprintf("%dh %02dm %02ds", hours, minutes, seconds)
+}
+
+// Duration formats a countdown in hours and minutes in the current language
+func Duration(d time.Duration) string { return current.Duration(d) }
+
+// LongDuration formats a countdown with seconds in the current language
+func LongDuration(d time.Duration) string { return current.LongDuration(d) }
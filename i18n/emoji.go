@@ -0,0 +1,61 @@
+package i18n
+
+import (
+	"strings"
+	"unicode"
+)
+
+// emoji reports whether messages keep their emoji
+var emoji = true
+
+// SetEmoji chooses whether messages keep their emoji. Without them output
+// suits terminals that cannot draw emoji and screen readers that would read
+// each one aloud.
+func SetEmoji(on bool) {
+	emoji = on
+}
+
+// Emoji reports whether messages keep their emoji
+func Emoji() bool {
+	return emoji
+}
+
+// message applies the emoji setting to a translated message
+func message(msg string) string {
+	if emoji {
+		return msg
+	}
+	return StripEmoji(msg)
+}
+
+// StripEmoji removes the emoji from s along with the spaces that separate
+// them from the text, e.g. "🕌 Jadwal Sholat" becomes "Jadwal Sholat"
+func StripEmoji(s string) string {
+	var b strings.Builder
+	skipSpace := false
+	for _, r := range s {
+		switch {
+		case isEmoji(r) || r == '\uFE0F' || r == '\u200D':
+			skipSpace = true
+		case skipSpace && r == ' ':
+		default:
+			skipSpace = false
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// isEmoji reports whether r is drawn as an emoji. Check marks and arrows
+// are kept since they read as text.
+func isEmoji(r rune) bool {
+	switch {
+	case r == '✓' || r == '✔' || r == '✗' || r == '✘':
+		return false
+	case r >= 0x1F000 && r <= 0x1FAFF, r >= 0x2600 && r <= 0x27BF,
+		r >= 0x231A && r <= 0x23FF, r == 0x2B50, r == 0x2B55:
+		return unicode.IsSymbol(r)
+	default:
+		return false
+	}
+}
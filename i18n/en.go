@@ -0,0 +1,436 @@
+package i18n
+
+// english translates the Indonesian messages to English
+var english = map[string]string{
+	// Prayer names
+	"Imsak":   "Imsak",
+	"Subuh":   "Fajr",
+	"Dzuhur":  "Dhuhr",
+	"Ashar":   "Asr",
+	"Maghrib": "Maghrib",
+	"Isya":    "Isha",
+
+	// Weekdays
+	"Minggu": "Sunday",
+	"Senin":  "Monday",
+	"Selasa": "Tuesday",
+	"Rabu":   "Wednesday",
+	"Kamis":  "Thursday",
+	"Jumat":  "Friday",
+	"Sabtu":  "Saturday",
+	"Min":    "Sun",
+	"Sen":    "Mon",
+	"Sel":    "Tue",
+	"Rab":    "Wed",
+	"Kam":    "Thu",
+	"Jum":    "Fri",
+	"Sab":    "Sat",
+
+	// Months
+	"Januari":   "January",
+	"Februari":  "February",
+	"Maret":     "March",
+	"April":     "April",
+	"Mei":       "May",
+	"Juni":      "June",
+	"Juli":      "July",
+	"Agustus":   "August",
+	"September": "September",
+	"Oktober":   "October",
+	"November":  "November",
+	"Desember":  "December",
+	"Jan":       "Jan",
+	"Feb":       "Feb",
+	"Mar":       "Mar",
+	"Apr":       "Apr",
+	"Jun":       "Jun",
+	"Jul":       "Jul",
+	"Agu":       "Aug",
+	"Sep":       "Sep",
+	"Okt":       "Oct",
+	"Nov":       "Nov",
+	"Des":       "Dec",
+
+	// Hijri months
+	"Muharram":      "Muharram",
+	"Safar":         "Safar",
+	"Rabiul Awal":   "Rabi' al-Awwal",
+	"Rabiul Akhir":  "Rabi' al-Akhir",
+	"Jumadil Awal":  "Jumada al-Ula",
+	"Jumadil Akhir": "Jumada al-Akhirah",
+	"Rajab":         "Rajab",
+	"Sya'ban":       "Sha'ban",
+	"Ramadhan":      "Ramadan",
+	"Syawal":        "Shawwal",
+	"Dzulqa'dah":    "Dhu al-Qa'dah",
+	"Dzulhijjah":    "Dhu al-Hijjah",
+
+	// Formats shared by dates and labels
+	"%s, %d %s %d":    "%s, %d %s %d",
+	"%d %s %d":        "%d %s %d",
+	"%d %s":           "%d %s",
+	"%d %s %d H":      "%d %s %d AH",
+	"%s (besok)":      "%s (tomorrow)",
+	"%s (hari ini)":   "%s (today)",
+	"%.1f%% selesai":  "%.1f%% done",
+	"%dm":             "%dm",
+	"%dh %02dm":       "%dh %02dm",
+	"%dm %02ds":       "%dm %02ds",
+	"%dh %02dm %02ds": "%dh %02dm %02ds",
+	"Error: %v":       "Error: %v",
+	"Peringatan: %v":  "Warning: %v",
+	"%v\nJalankan '%s --help' untuk melihat penggunaan.":                                           "%v\nRun '%s --help' for usage.",
+	"perintah tidak dikenal %q untuk %q":                                                           "unknown command %q for %q",
+	"Mungkin maksud Anda: %s":                                                                      "Did you mean: %s",
+	"tidak dapat membaca file konfigurasi %s: %v":                                                  "cannot read config file %s: %v",
+	"Perbaiki dengan 'salat config edit', atau jalankan 'salat doctor' untuk pemeriksaan lengkap.": "Fix it with 'salat config edit', or run 'salat doctor' for a full check.",
+	"Periksa nilai di file konfigurasi dan variabel lingkungan SALAT_*, atau jalankan 'salat doctor' untuk pemeriksaan lengkap.": "Check the values in the config file and the SALAT_* environment variables, or run 'salat doctor' for a full check.",
+	"bahasa tidak didukung: %q (pilih %s)": "unsupported language: %q (choose %s)",
+
+	// show, now, next, watch, world
+	"AKTIF":                              "ACTIVE",
+	"WAKTU":                              "PRAYER",
+	"JAM":                                "TIME",
+	"STATUS":                             "STATUS",
+	"Berikutnya":                         "Next",
+	"Dalam":                              "In",
+	"Saat ini":                           "Current",
+	"Jam setempat":                       "Local time",
+	"Tanggal":                            "Date",
+	"Jadwal Sholat":                      "Prayer Times",
+	"🕌 Jadwal Sholat":                    "🕌 Prayer Times",
+	"🕌 Jadwal Sholat - %s":               "🕌 Prayer Times - %s",
+	"🕌 JADWAL SHOLAT LIVE":               "🕌 LIVE PRAYER TIMES",
+	"🌍 Jadwal Sholat Dunia":              "🌍 World Prayer Times",
+	"Jadwal Sholat %s - %s":              "Prayer Times %s - %s",
+	"Jadwal Sholat %s - %s s/d %s":       "Prayer Times %s - %s to %s",
+	"Waktu Sholat Hari Ini:":             "Today's Prayer Times:",
+	"Waktu sholat saat ini: %s%s":        "Current prayer: %s%s",
+	"Tidak ada waktu sholat saat ini":    "No current prayer",
+	"Sholat berikutnya: %s%s":            "Next prayer: %s%s",
+	"⏰ Sholat berikutnya: %s%s dalam %s": "⏰ Next prayer: %s%s in %s",
+	"⏱️ Sholat berikutnya: %s%s dalam %s": "⏱️ Next prayer: %s%s in %s",
+	"%s%s pukul %s":              "%s%s at %s",
+	"Countdown: %s":              "Countdown: %s",
+	"Waktu: %s":                  "Time: %s",
+	"Waktu: %s (dalam %s)":       "Time: %s (in %s)",
+	"dalam %s":                   "in %s",
+	"Dimulai: %s (%s yang lalu)": "Started: %s (%s ago)",
+	"│ 🔔 WAKTU SHOLAT %s TELAH TIBA! │": "│ 🔔 IT IS TIME FOR %s! │",
+	"Keluar dari mode watch...":         "Leaving watch mode...",
+	"📍 Lokasi: %s":                      "📍 Location: %s",
+	"📍 Koordinat: %.6f, %.6f":           "📍 Coordinates: %.6f, %.6f",
+	"🕐 Timezone: %s":                    "🕐 Time zone: %s",
+	"🧮 Metode: %s":                      "🧮 Method: %s",
+	"Semua waktu dalam %s (%s)":         "All times in %s (%s)",
+	"Lokasi Anda":                       "Your location",
+	"Lokasi (%.4f, %.4f)":               "Location (%.4f, %.4f)",
+	"Lokasi (%.6f, %.6f)":               "Location (%.6f, %.6f)",
+	"Waktu sholat ini tidak terjadi di lokasi tersebut pada tanggal ini.": "This prayer time does not occur at that location on this date.",
+	"tidak terdefinisi":                           "undefined",
+	"waktu sholat tidak terdefinisi":              "prayer time is undefined",
+	"%w: %s di lintang %.4f pada %s":              "%w: %s at latitude %.4f on %s",
+	"gagal menghitung jadwal sholat: %w":          "failed to calculate prayer times: %w",
+	"gagal menghitung jadwal sholat untuk %s: %w": "failed to calculate prayer times for %s: %w",
+	"gagal membaca file lokasi: %v":               "failed to read locations file: %v",
+	"tidak ada lokasi: gunakan --location, buat file %s, atau tambahkan profil dengan 'salat profile add'": "no locations: use --location, create the file %s, or add a profile with 'salat profile add'",
+	"timezone tidak valid %s: %v":            "invalid time zone %s: %v",
+	"timezone tidak valid: %v":               "invalid time zone: %v",
+	"timezone tidak valid: %s":               "invalid time zone: %s",
+	"timezone wajib diisi":                   "time zone is required",
+	"gagal mendeteksi timezone untuk %q: %v": "failed to detect the time zone of %q: %v",
+	"gagal mendeteksi timezone: %v":          "failed to detect the time zone: %v",
+
+	// kiosk and tui
+	"Adzan %s":                     "Adhan %s",
+	"Iqamah %s":                    "Iqamah %s",
+	"--iqamah tidak boleh negatif": "--iqamah must not be negative",
+	"--iqamah-for tidak valid: %s=%d (gunakan subuh, dzuhur, ashar, maghrib, atau isya)": "invalid --iqamah-for: %s=%d (use subuh, dzuhur, ashar, maghrib, or isya)",
+	"salat kiosk membutuhkan terminal":                                                   "salat kiosk needs a terminal",
+	"salat tui membutuhkan terminal, gunakan 'salat show' untuk output biasa":            "salat tui needs a terminal, use 'salat show' for plain output",
+	"gagal menjalankan kiosk: %v":                                                        "failed to run the kiosk: %v",
+	"gagal menjalankan dashboard: %v":                                                    "failed to run the dashboard: %v",
+	"←/→ hari • [/] bulan • t hari ini • v tampilan hari/bulan • m metode • q keluar":    "←/→ day • [/] month • t today • v day/month view • m method • q quit",
+	"←→ hari • [] bulan • t • v • m • q":                                                 "←→ day • [] month • t • v • m • q",
+
+	// digest
+	"✅ Digest berhasil dikirim!":               "✅ Digest sent!",
+	"📧 Mengirim digest ke %d penerima...":      "📧 Sending the digest to %d recipients...",
+	"tidak ada penerima digest":                "no digest recipients",
+	"smtp.host belum dikonfigurasi":            "smtp.host is not configured",
+	"smtp.from belum dikonfigurasi":            "smtp.from is not configured",
+	"gagal menyusun HTML digest: %v":           "failed to build the digest HTML: %v",
+	"gagal menyusun email: %v":                 "failed to build the email: %v",
+	"gagal mengirim email: %v":                 "failed to send the email: %v",
+	"tidak dapat terhubung ke server SMTP: %v": "cannot connect to the SMTP server: %v",
+	"tidak dapat memulai sesi SMTP: %v":        "cannot start the SMTP session: %v",
+	"STARTTLS gagal: %v":                       "STARTTLS failed: %v",
+	"autentikasi SMTP gagal: %v":               "SMTP authentication failed: %v",
+	"SMTP MAIL FROM ditolak: %v":               "SMTP MAIL FROM rejected: %v",
+	"SMTP RCPT TO %s ditolak: %v":              "SMTP RCPT TO %s rejected: %v",
+	"SMTP DATA gagal: %v":                      "SMTP DATA failed: %v",
+	"tidak dapat menulis pesan: %v":            "cannot write the message: %v",
+	"server SMTP menolak pesan: %v":            "the SMTP server rejected the message: %v",
+
+	// setup and location
+	"Selamat datang di setup salat CLI!":                                                      "Welcome to the salat CLI setup!",
+	"Konfigurasi belum lengkap. Menjalankan setup...":                                         "The configuration is incomplete. Running setup...",
+	"Konfigurasi berhasil disimpan!":                                                          "Configuration saved!",
+	"✅ Konfigurasi berhasil disimpan!":                                                        "✅ Configuration saved!",
+	"Jalankan 'salat show' untuk melihat jadwal sholat hari ini.":                             "Run 'salat show' to see today's prayer times.",
+	"Jalankan 'salat setup' untuk mengatur lokasi.":                                           "Run 'salat setup' to set your location.",
+	"🔍 Mencari koordinat lokasi...":                                                           "🔍 Looking up the coordinates...",
+	"🔍 Mencari nama lokasi...":                                                                "🔍 Looking up the location name...",
+	"📍 Lokasi ditemukan: %s":                                                                  "📍 Location found: %s",
+	"🌍 Mengatur lokasi: %s":                                                                   "🌍 Setting location: %s",
+	"Ditemukan %d lokasi untuk %q, pilih salah satu:":                                         "Found %d locations for %q, choose one:",
+	"lokasi %q cocok dengan %d tempat:":                                                       "location %q matches %d places:",
+	"gunakan nama yang lebih spesifik atau --first untuk memakai hasil teratas":               "use a more specific name or --first to take the top result",
+	"Masukkan latitude lokasi Anda:":                                                          "Enter the latitude of your location:",
+	"Masukkan longitude lokasi Anda:":                                                         "Enter the longitude of your location:",
+	"Masukkan timezone (contoh: Asia/Jakarta):":                                               "Enter the time zone (e.g. Asia/Jakarta):",
+	"Timezone lokasi: %s. Ubah?":                                                              "Time zone of the location: %s. Change it?",
+	"Pilih metode perhitungan waktu sholat:":                                                  "Choose the calculation method:",
+	"gagal membaca input latitude: %v":                                                        "failed to read the latitude: %v",
+	"gagal membaca input longitude: %v":                                                       "failed to read the longitude: %v",
+	"gagal membaca input timezone: %v":                                                        "failed to read the time zone: %v",
+	"gagal membaca konfirmasi timezone: %v":                                                   "failed to read the time zone confirmation: %v",
+	"gagal membaca pilihan lokasi: %v":                                                        "failed to read the location choice: %v",
+	"gagal membaca pilihan metode: %v":                                                        "failed to read the method choice: %v",
+	"lokasi wajib diberikan dalam mode non-interaktif, contoh: salat setup \"Jakarta\" --yes": "a location is required in non-interactive mode, e.g. salat setup \"Jakarta\" --yes",
+	"Peringatan: tidak dapat menentukan nama lokasi: %v":                                      "Warning: cannot determine the location name: %v",
+	"⚠️  Peringatan: timezone %s tidak sesuai dengan lokasi (%s)":                             "⚠️  Warning: time zone %s does not match the location (%s)",
+	"   Jalankan 'salat config set timezone %s' untuk menyesuaikan.":                          "   Run 'salat config set timezone %s' to fix it.",
+	"Lokasi diperbarui: %s":                                                                   "Location updated: %s",
+	"Timezone diperbarui: %s (sebelumnya %s)":                                                 "Time zone updated: %s (was %s)",
+	"Lokasi diatur ke: %s (%.6f, %.6f)":                                                       "Location set to: %s (%.6f, %.6f)",
+	"tidak dapat menemukan lokasi %q: %w":                                                     "cannot find the location %q: %w",
+	"tidak dapat menemukan lokasi: %w":                                                        "cannot find the location: %w",
+	"gagal mencari lokasi: %w":                                                                "failed to look up the location: %w",
+
+	// config
+	"Konfigurasi saat ini:":                            "Current configuration:",
+	"  profil: %s":                                     "  profile: %s",
+	"  lokasi: %s":                                     "  location: %s",
+	"Timezone diatur ke: %s":                           "Time zone set to: %s",
+	"Latitude diatur ke: %.6f":                         "Latitude set to: %.6f",
+	"Longitude diatur ke: %.6f":                        "Longitude set to: %.6f",
+	"Koordinat diatur ke: %.6f, %.6f":                  "Coordinates set to: %.6f, %.6f",
+	"Metode perhitungan diatur ke: %s":                 "Calculation method set to: %s",
+	"Metode perhitungan diatur ke: auto (saat ini %s)": "Calculation method set to: auto (currently %s)",
+	"Geocoding API diatur ke: %s":                      "Geocoding API set to: %s",
+	"Geocoding URL diatur ke: %s":                      "Geocoding URL set to: %s",
+	"Email kontak geocoding diatur ke: %s":             "Geocoding contact email set to: %s",
+	"Cache geocoding berlaku selama: %s":               "Geocoding cache lasts: %s",
+	"Cache geocoding dinonaktifkan":                    "Geocoding cache disabled",
+	"Penyesuaian %s diatur ke: %+d menit":              "%s adjustment set to: %+d minutes",
+	"SMTP host diatur ke: %s":                          "SMTP host set to: %s",
+	"SMTP port diatur ke: %d":                          "SMTP port set to: %d",
+	"SMTP username diatur ke: %s":                      "SMTP username set to: %s",
+	"SMTP password diatur":                             "SMTP password set",
+	"Pengirim email diatur ke: %s":                     "Email sender set to: %s",
+	"Penerima digest diatur ke: %s":                    "Digest recipients set to: %s",
+	"API tidak valid. Pilih salah satu dari: %s":       "invalid API. Choose one of: %s",
+	"URL geocoding tidak valid: %s":                    "invalid geocoding URL: %s",
+	"durasi cache tidak valid (contoh: 720h, atau 0 untuk menonaktifkan): %s": "invalid cache duration (e.g. 720h, or 0 to disable): %s",
+	"penyesuaian harus berupa jumlah menit (contoh: 2 atau -3): %s":           "an adjustment must be a number of minutes (e.g. 2 or -3): %s",
+	"port SMTP tidak valid: %s": "invalid SMTP port: %s",
+	"metode tidak valid: %s":    "invalid method: %s",
+	"metode tidak valid. Pilih salah satu dari: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto":     "invalid method. Choose one of: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto",
+	"metode tidak valid: %s. Pilih salah satu dari: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto": "invalid method: %s. Choose one of: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto",
+	"latitude tidak valid: %v":        "invalid latitude: %v",
+	"longitude tidak valid: %v":       "invalid longitude: %v",
+	"nilai latitude tidak valid: %v":  "invalid latitude value: %v",
+	"nilai longitude tidak valid: %v": "invalid longitude value: %v",
+	"koordinat tidak valid: %v":       "invalid coordinates: %v",
+	"koordinat tidak valid: %v, %v":   "invalid coordinates: %v, %v",
+	"koordinat di luar jangkauan: %.6f, %.6f (latitude -90..90, longitude -180..180)":             "coordinates out of range: %.6f, %.6f (latitude -90..90, longitude -180..180)",
+	"koordinat di luar jangkauan: %f, %f":                                                         "coordinates out of range: %f, %f",
+	"timezone tidak dikenal: %s (gunakan nama IANA seperti Asia/Jakarta)":                         "unknown time zone: %s (use an IANA name such as Asia/Jakarta)",
+	"lokasi tidak bisa dikosongkan, ubah dengan 'salat config set location' atau 'salat setup'":   "the location cannot be unset, change it with 'salat config set location' or 'salat setup'",
+	"gagal menyimpan konfigurasi: %v":                                                             "failed to save the configuration: %v",
+	"gagal membaca konfigurasi: %v":                                                               "failed to read the configuration: %v",
+	"gagal membaca konfigurasi: %v (gunakan --replace untuk menggantinya, cadangan tetap dibuat)": "failed to read the configuration: %v (use --replace to replace it, a backup is still made)",
+	"gagal memuat konfigurasi: %v":                                                                "failed to load the configuration: %v",
+	"konfigurasi lokasi belum ada":                                                                "no location is configured",
+	"Konfigurasi tidak valid: %v":                                                                 "Invalid configuration: %v",
+	"Perbaiki dengan 'salat config set', atau jalankan 'salat doctor' untuk pemeriksaan lengkap.": "Fix it with 'salat config set', or run 'salat doctor' for a full check.",
+	"%s dikembalikan ke default":                                                                  "%s reset to the default",
+	"berikan kunci konfigurasi atau --all":                                                        "give configuration keys or --all",
+	"Belum ada file konfigurasi, tidak ada yang direset.":                                         "There is no configuration file yet, nothing to reset.",
+	"Konfigurasi direset. Cadangan: %s":                                                           "Configuration reset. Backup: %s",
+	"Cadangan konfigurasi lama: %s":                                                               "Backup of the old configuration: %s",
+	"Konfigurasi diekspor ke %s":                                                                  "Configuration exported to %s",
+	"Konfigurasi diimpor ke %s":                                                                   "Configuration imported into %s",
+	"Konfigurasi diperbarui ke versi %d (cadangan: %s)":                                           "Configuration upgraded to version %d (backup: %s)",
+	"Tidak ada perubahan.":                                                                        "No changes.",
+	"Perubahan dibatalkan, konfigurasi tidak diubah.":                                             "Changes discarded, the configuration is unchanged.",
+	"Edit lagi? (Tidak = batalkan perubahan)":                                                     "Edit again? (No = discard the changes)",
+	"⚠️  Nilai konfigurasi berikut masih tidak valid:":                                            "⚠️  These configuration values are still invalid:",
+	"belum ada file konfigurasi di %s":                                                            "there is no configuration file at %s",
+	"format tidak valid: %s (pilih yaml atau json)":                                               "invalid format: %s (choose yaml or json)",
+	"file impor tidak valid: %v":                                                                  "invalid import file: %v",
+	"gagal membuka file impor: %v":                                                                "failed to open the import file: %v",
+	"gagal membuat file ekspor: %v":                                                               "failed to create the export file: %v",
+	"gagal mengekspor konfigurasi: %v":                                                            "failed to export the configuration: %v",
+	"gagal menghapus konfigurasi: %v":                                                             "failed to remove the configuration: %v",
+	"gagal menjalankan editor %s: %v":                                                             "failed to run the editor %s: %v",
+	"gagal membaca file konfigurasi: %v":                                                          "failed to read the configuration file: %v",
+	"gagal membaca file konfigurasi yang diperbarui: %v":                                          "failed to read the upgraded configuration file: %v",
+	"gagal menulis file konfigurasi yang diperbarui: %v":                                          "failed to write the upgraded configuration file: %v",
+	"gagal membuat cadangan konfigurasi: %v":                                                      "failed to back up the configuration: %v",
+	"gagal mencadangkan file konfigurasi: %v":                                                     "failed to back up the configuration file: %v",
+	"gagal memperbarui konfigurasi ke versi %d: %v":                                               "failed to upgrade the configuration to version %d: %v",
+	"versi konfigurasi %d lebih baru dari yang didukung salat ini (%d), perbarui salat":           "configuration version %d is newer than this salat supports (%d), update salat",
+	"versi konfigurasi tidak valid: %v":                                                           "invalid configuration version: %v",
+	"tidak dapat membaca isi konfigurasi: %v":                                                     "cannot decode the configuration: %v",
+	"profiles harus berupa map, bukan %T":                                                         "profiles must be a map, not %T",
+	"Bahasa diatur ke: %s":                                                                        "Language set to: %s",
+	"kunci konfigurasi tidak valid. Pilih salah satu dari: timezone, location, latitude, longitude, method, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, adjustments.<waktu>, smtp.host, smtp.port, smtp.username, smtp.password, smtp.from, digest.recipients, display.no_color, display.no_emoji, display.screen_reader, display.theme, display.clock, display.seconds, display.pasaran, themes.<nama>.<bagian>, formats.<nama>": "invalid configuration key. Choose one of: timezone, location, latitude, longitude, method, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, adjustments.<prayer>, smtp.host, smtp.port, smtp.username, smtp.password, smtp.from, digest.recipients, display.no_color, display.no_emoji, display.screen_reader, display.theme, display.clock, display.seconds, display.pasaran, themes.<name>.<part>, formats.<name>",
+	"kunci konfigurasi tidak valid: %s. Pilih salah satu dari: method, adjustments, adjustments.<waktu>, active_profile, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, smtp, smtp.<kunci>, digest.recipients, display, display.<kunci>, themes, themes.<nama>, themes.<nama>.<bagian>, formats, formats.<nama>":                                                                                                                     "invalid configuration key: %s. Choose one of: method, adjustments, adjustments.<prayer>, active_profile, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, smtp, smtp.<key>, digest.recipients, display, display.<key>, themes, themes.<name>, themes.<name>.<part>, formats, formats.<name>",
+
+	// validation
+	"%s: %s (nilai: %v)":                                             "%s: %s (value: %v)",
+	"konfigurasi tidak valid:":                                       "invalid configuration:",
+	"lebih baru dari yang didukung salat ini (%d)":                   "is newer than this salat supports (%d)",
+	"harus salah satu dari %s":                                       "must be one of %s",
+	"harus salah satu dari %s, auto":                                 "must be one of %s, auto",
+	"tidak ada profil dengan nama ini":                               "there is no profile with this name",
+	"harus berupa URL http atau https":                               "must be an http or https URL",
+	"harus berupa durasi seperti 720h, atau 0 untuk menonaktifkan":   "must be a duration such as 720h, or 0 to disable",
+	"harus di antara 1 dan 65535":                                    "must be between 1 and 65535",
+	"harus di antara -90 dan 90":                                     "must be between -90 and 90",
+	"harus di antara -180 dan 180":                                   "must be between -180 and 180",
+	"harus di antara -%d dan %d menit":                               "must be between -%d and %d minutes",
+	"harus berupa alamat email":                                      "must be an email address",
+	"wajib diisi":                                                    "is required",
+	"timezone tidak dikenal, gunakan nama IANA seperti Asia/Jakarta": "unknown time zone, use an IANA name such as Asia/Jakarta",
+	"gunakan huruf kecil, angka, '-' dan '_'":                        "use lowercase letters, digits, '-' and '_'",
+	"geocoding_cache_ttl tidak valid %q: gunakan durasi seperti 720h, atau 0 untuk menonaktifkan": "invalid geocoding_cache_ttl %q: use a duration such as 720h, or 0 to disable",
+
+	// profiles
+	"Belum ada profil. Tambahkan dengan 'salat profile add [nama] [lokasi]'.": "No profiles yet. Add one with 'salat profile add [name] [location]'.",
+	"Profil %s dihapus":                                                   "Profile %s removed",
+	"Profil aktif: %s (%s)":                                               "Active profile: %s (%s)",
+	"✅ Profil %s ditambahkan: %s (%.6f, %.6f) • %s":                       "✅ Profile %s added: %s (%.6f, %.6f) • %s",
+	"Jalankan 'salat profile use %s' untuk memakainya.":                   "Run 'salat profile use %s' to use it.",
+	"profil %q sudah ada, hapus dulu dengan 'salat profile remove %s'":    "profile %q already exists, remove it first with 'salat profile remove %s'",
+	"profil %q tidak ditemukan":                                           "profile %q not found",
+	"profil default tidak bisa dihapus, ubah dengan 'salat setup'":        "the default profile cannot be removed, change it with 'salat setup'",
+	"nama profil tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'": "invalid profile name %q: use lowercase letters, digits, '-' and '_'",
+
+	// gazetteer and geocoding
+	"✅ %d lokasi diimpor ke %s":                          "✅ %d locations imported into %s",
+	"⬇️  Mengunduh %s...":                                "⬇️  Downloading %s...",
+	"server mengembalikan status %d":                     "the server returned status %d",
+	"API geocoding mengembalikan status %d":              "the geocoding API returned status %d",
+	"gagal membaca gazetteer: %v":                        "failed to read the gazetteer: %v",
+	"gagal memuat gazetteer: %v":                         "failed to load the gazetteer: %v",
+	"gagal menyimpan gazetteer: %v":                      "failed to save the gazetteer: %v",
+	"gazetteer tidak valid: %v":                          "invalid gazetteer: %v",
+	"%s: kolom yang diharapkan %s":                       "%s: expected columns %s",
+	"%s: latitude tidak valid %q untuk %s":               "%s: invalid latitude %q for %s",
+	"%s: longitude tidak valid %q untuk %s":              "%s: invalid longitude %q for %s",
+	"lokasi %q tidak ditemukan di gazetteer (%d lokasi)": "location %q not found in the gazetteer (%d locations)",
+	"latitude dari API tidak valid: %v":                  "invalid latitude from the API: %v",
+	"longitude dari API tidak valid: %v":                 "invalid longitude from the API: %v",
+	"penyedia geocoding tidak dikenal %q (tersedia: %s)": "unknown geocoding provider %q (available: %s)",
+	"tidak ada hasil untuk %s":                           "no results for %s",
+	"gagal memuat batas timezone: %v":                    "failed to load the time zone boundaries: %v",
+	"timezone tidak ditemukan untuk %f, %f":              "no time zone found for %f, %f",
+
+	// doctor
+	"🩺 Pemeriksaan salat":                         "🩺 salat checkup",
+	"%d pemeriksaan gagal, %d peringatan":         "%d checks failed, %d warnings",
+	"Selesai dengan %d peringatan.":               "Finished with %d warnings.",
+	"Semua pemeriksaan berhasil.":                 "All checks passed.",
+	"Konfigurasi":                                 "Configuration",
+	"Timezone":                                    "Time zone",
+	"Geocoding":                                   "Geocoding",
+	"Perhitungan":                                 "Calculation",
+	"lokasi file konfigurasi tidak diketahui: %v": "unknown configuration file location: %v",
+	"file konfigurasi %s belum ada (jalankan 'salat setup')": "configuration file %s does not exist (run 'salat setup')",
+	"file konfigurasi %s tidak bisa dibaca: %v":              "config file %s cannot be read: %v",
+	"file konfigurasi: %s":                                   "configuration file: %s",
+	"konfigurasi tidak bisa dibaca: %v":                      "the configuration cannot be read: %v",
+	"semua nilai konfigurasi valid (versi %d)":               "all configuration values are valid (version %d)",
+	"lokasi belum diatur (jalankan 'salat setup')":           "no location set (run 'salat setup')",
+	"profil: %s":                                 "profile: %s",
+	"lokasi: %s (%.6f, %.6f)":                    "location: %s (%.6f, %.6f)",
+	"database timezone tidak tersedia: %v":       "the time zone database is not available: %v",
+	"database timezone tersedia":                 "the time zone database is available",
+	"timezone %q tidak dikenal":                  "unknown time zone %q",
+	"timezone: %s (%s)":                          "time zone: %s (%s)",
+	"timezone lokasi tidak dapat ditentukan: %v": "the time zone of the location cannot be determined: %v",
+	"timezone %s tidak sesuai dengan lokasi (%s), jalankan 'salat config set timezone %s'": "time zone %s does not match the location (%s), run 'salat config set timezone %s'",
+	"timezone sesuai dengan lokasi":                                               "the time zone matches the location",
+	"direktori konfigurasi tidak ditemukan: %v":                                   "configuration directory not found: %v",
+	"gazetteer offline tidak bisa dibaca: %v":                                     "the offline gazetteer cannot be read: %v",
+	"gazetteer offline: %d lokasi":                                                "offline gazetteer: %d locations",
+	"provider: offline, tidak memerlukan internet":                                "provider: offline, no internet needed",
+	"provider %s tidak diperiksa (--offline)":                                     "provider %s not checked (--offline)",
+	"provider %s tidak dapat dihubungi: %v (geocoding memakai gazetteer offline)": "provider %s cannot be reached: %v (geocoding falls back to the offline gazetteer)",
+	"provider %s dapat dihubungi (%d ms)":                                         "provider %s is reachable (%d ms)",
+	"  ⏭️  dilewati karena konfigurasi belum valid":                               "  ⏭️  skipped because the configuration is not valid yet",
+	"metode: %s":                    "method: %s",
+	"%s (%s) tidak sebelum %s (%s)": "%s (%s) is not before %s (%s)",
+	"jadwal hari ini berurutan: Subuh %s, Dzuhur %s, Maghrib %s":     "today's times are in order: Fajr %s, Dhuhr %s, Maghrib %s",
+	"Dzuhur %s menyimpang %.0f menit dari tengah hari matahari (%s)": "Dhuhr %s is %.0f minutes off solar noon (%s)",
+	"Dzuhur dalam %.0f menit dari tengah hari matahari":              "Dhuhr is within %.0f minutes of solar noon",
+
+	// formats
+	"gagal menjalankan template: %v":                                        "failed to run the template: %v",
+	"nama template tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'": "invalid template name %q: use lowercase letters, digits, '-' and '_'",
+	"template tidak valid: %v":                                              "invalid template: %v",
+	"Template %s disimpan, pakai dengan --format %s":                        "Template %s saved, use it with --format %s",
+
+	// status
+	"gaya tidak dikenal %q, pilih salah satu dari: %s": "unknown style %q, choose one of: %s",
+
+	// prompt
+	"shell tidak dikenal %q, pilih salah satu dari: %s": "unknown shell %q, choose one of: %s",
+
+	// display
+	"OK:":                                "OK:",
+	"Peringatan:":                        "Warning:",
+	"Gagal:":                             "Failed:",
+	"nilai harus true atau false: %s":    "value must be true or false: %s",
+	"%s diatur ke: %t":                   "%s set to: %t",
+	"kurang dari satu menit":             "less than a minute",
+	"%d menit":                           "%d minutes",
+	"%d jam":                             "%d hours",
+	"%d jam %d menit":                    "%d hours %d minutes",
+	"Jadwal sholat %s di %s, metode %s.": "Prayer times for %s in %s, %s method.",
+	"%s pukul %s, sedang berlangsung.":   "%s at %s, in progress.",
+	"%s pukul %s, sudah lewat.":          "%s at %s, passed.",
+	"%s pukul %s, %s lagi.":              "%s at %s, in %s.",
+	"%s pukul %s.":                       "%s at %s.",
+	"Sholat berikutnya %s pukul %s, %s lagi.":          "Next prayer %s at %s, in %s.",
+	"Pukul %s di %s.":                                  "It is %s in %s.",
+	"Waktu sholat saat ini %s, dimulai pukul %s.":      "Current prayer time %s, started at %s.",
+	"Saat ini bukan waktu sholat.":                     "It is not a prayer time now.",
+	"tengah malam":                                     "midnight",
+	"%d persen waktu dari %s sampai %s sudah berlalu.": "%d percent of the time from %s to %s has passed.",
+	"Waktu %s telah tiba, pukul %s.":                   "It is time for %s, %s.",
+
+	// themes
+	"warna tidak dikenal %q":                                            "unknown colour %q",
+	"warna atau atribut tidak dikenal %q":                               "unknown colour or attribute %q",
+	"nama tema tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'": "invalid theme name %q: use lowercase letters, digits, '-' and '_'",
+	"tidak ada tema dengan nama %q":                                     "there is no theme named %q",
+	"gunakan themes.<nama>.<bagian>, dengan bagian salah satu dari: %s": "use themes.<name>.<part>, with part one of: %s",
+	"gagal membaca tema: %v":                                            "failed to read themes: %v",
+	"tema tidak dikenal %q, pilih salah satu dari: %s":                  "unknown theme %q, choose one of: %s",
+	"Tema diatur ke: %s":                                                "Theme set to: %s",
+	"Tema %s: %s diatur ke %s, pakai dengan --theme %s":                 "Theme %s: %s set to %s, use it with --theme %s",
+	"Judul": "Title",
+	"Lewat": "Passed",
+
+	// clock and pasaran
+	"%s %s, %d %s %d":          "%s %s, %d %s %d",
+	"AM":                       "AM",
+	"PM":                       "PM",
+	"harus 12h atau 24h":       "must be 12h or 24h",
+	"Format jam diatur ke: %s": "Clock format set to: %s",
+	"format jam tidak valid %q, pilih 12h atau 24h": "invalid clock format %q, choose 12h or 24h",
+}
@@ -0,0 +1,162 @@
+// Package i18n translates the messages of salat. Messages are written in
+// Indonesian in the source and looked up by that text in the catalog of the
+// current language; messages missing from a catalog are shown in Indonesian.
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Lang is a supported language, named by its ISO 639-1 code
+type Lang string
+
+// Supported languages
+const (
+	Indonesian Lang = "id"
+	English    Lang = "en"
+	Malay      Lang = "ms"
+	Arabic     Lang = "ar"
+)
+
+// Default is the language of the source messages
+const Default = Indonesian
+
+// Languages lists the supported languages
+var Languages = []Lang{Indonesian, English, Malay, Arabic}
+
+// catalogs maps the Indonesian messages to their translation, per language
+var catalogs = map[Lang]map[string]string{
+	English: english,
+	Malay:   malay,
+	Arabic:  arabic,
+}
+
+// current is the language messages are translated to
+var current = Default
+
+// Parse returns the language of a code such as "en", "ms-MY", or a locale
+// such as "ar_SA.UTF-8"
+func Parse(s string) (Lang, error) {
+	code := strings.ToLower(s)
+	if i := strings.IndexAny(code, "_-.@"); i >= 0 {
+		code = code[:i]
+	}
+	for _, l := range Languages {
+		if string(l) == code {
+			return l, nil
+		}
+	}
+	return "", Errorf("bahasa tidak didukung: %q (pilih %s)", s, strings.Join(Codes(), ", "))
+}
+
+// Codes returns the codes of the supported languages
+func Codes() []string {
+	codes := make([]string, len(Languages))
+	for i, l := range Languages {
+		codes[i] = string(l)
+	}
+	return codes
+}
+
+// FromEnv returns the language of the locale set by LC_ALL, LC_MESSAGES, or
+// LANG, and Default when the locale is unset or not supported
+func FromEnv() Lang {
+	for _, key := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		value := os.Getenv(key)
+		if value == "" {
+			continue
+		}
+		if l, err := Parse(value); err == nil {
+			return l
+		}
+		// The first locale set decides, as with gettext
+		break
+	}
+	return Default
+}
+
+// Set changes the language messages are translated to
+func Set(l Lang) {
+	current = l
+}
+
+// Current returns the language messages are translated to
+func Current() Lang {
+	return current
+}
+
+// Name returns the name of the language in that language
+func (l Lang) Name() string {
+	switch l {
+	case English:
+		return "English"
+	case Malay:
+		return "Bahasa Melayu"
+	case Arabic:
+		return "العربية"
+	default:
+		return "Bahasa Indonesia"
+	}
+}
+
+// RTL reports whether the language is written right to left
+func (l Lang) RTL() bool {
+	return l == Arabic
+}
+
+// Dir returns the HTML text direction of the language, "rtl" or "ltr"
+func (l Lang) Dir() string {
+	if l.RTL() {
+		return "rtl"
+	}
+	return "ltr"
+}
+
+// T returns msg in the language
+func (l Lang) T(msg string) string {
+	if translated, ok := catalogs[l][msg]; ok {
+		return translated
+	}
+	return msg
+}
+
+// Sprintf formats the translation of format in the language. In right to
+// left languages, string arguments such as place names are isolated so
+// left to right text does not reorder the sentence around it.
+func (l Lang) Sprintf(format string, args ...interface{}) string {
+	return fmt.Sprintf(l.T(format), l.isolate(args)...)
+}
+
+// isolate wraps the string arguments of a right to left message in Unicode
+// first strong isolates
+func (l Lang) isolate(args []interface{}) []interface{} {
+	if !l.RTL() {
+		return args
+	}
+	isolated := make([]interface{}, len(args))
+	for i, arg := range args {
+		if s, ok := arg.(string); ok && s != "" {
+			arg = "\u2068" + s + "\u2069"
+		}
+		isolated[i] = arg
+	}
+	return isolated
+}
+
+// T returns msg in the current language, without emoji when they are off
+func T(msg string) string {
+	return message(current.T(msg))
+}
+
+// Sprintf formats the translation of format in the current language
+func Sprintf(format string, args ...interface{}) string {
+	return fmt.Sprintf(message(current.T(format)), current.isolate(args)...)
+}
+
+// Errorf returns an error formatted like fmt.Errorf from the translation of
+// format in the current language, so %w keeps wrapping errors
+func Errorf(format string, args ...interface{}) error {
+	return fmt.Errorf(message(current.T(format)), current.isolate(args)...)
+}
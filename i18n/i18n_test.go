@@ -0,0 +1,291 @@
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Lang
+	}{
+		{"id", Indonesian},
+		{"EN", English},
+		{"ms-MY", Malay},
+		{"ar_SA.UTF-8", Arabic},
+		{"en_US@euro", English},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("Parse(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+		}
+	}
+	for _, in := range []string{"", "fr", "C", "english"} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", in)
+		}
+	}
+}
+
+func TestFromEnv(t *testing.T) {
+	tests := []struct {
+		all, messages, lang string
+		want                Lang
+	}{
+		{"", "", "", Default},
+		{"", "", "en_GB.UTF-8", English},
+		{"", "ms_MY.UTF-8", "en_GB.UTF-8", Malay},
+		{"ar_EG.UTF-8", "ms_MY.UTF-8", "en_GB.UTF-8", Arabic},
+		// The first locale set decides, even when it is not supported
+		{"C.UTF-8", "", "en_GB.UTF-8", Default},
+		{"", "fr_FR.UTF-8", "en_GB.UTF-8", Default},
+	}
+	for _, tt := range tests {
+		t.Setenv("LC_ALL", tt.all)
+		t.Setenv("LC_MESSAGES", tt.messages)
+		t.Setenv("LANG", tt.lang)
+		if got := FromEnv(); got != tt.want {
+			t.Errorf("FromEnv() with LC_ALL=%q LC_MESSAGES=%q LANG=%q = %q, want %q", tt.all, tt.messages, tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	if got := English.T("pesan yang tidak ada di katalog"); got != "pesan yang tidak ada di katalog" {
+		t.Errorf("missing message = %q, want the source", got)
+	}
+	if got := Indonesian.T("Subuh"); got != "Subuh" {
+		t.Errorf("Indonesian.T(Subuh) = %q", got)
+	}
+	if got := English.Sprintf("%s (besok)", English.T("Subuh")); got != "Fajr (tomorrow)" {
+		t.Errorf("English.Sprintf = %q", got)
+	}
+	if got, want := Arabic.Sprintf("Lokasi diperbarui: %s", "Jakarta"), "تم تحديث الموقع: \u2068Jakarta\u2069"; got != want {
+		t.Errorf("Arabic.Sprintf = %q, want %q", got, want)
+	}
+}
+
+func TestDate(t *testing.T) {
+	date := time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		lang Lang
+		want string
+	}{
+		{Indonesian, "Senin, 11 Maret 2024"},
+		{English, "Monday, 11 March 2024"},
+		{Malay, "Isnin, 11 Mac 2024"},
+		{Arabic, "\u2068الاثنين\u2069، 11 \u2068مارس\u2069 2024"},
+	}
+	for _, tt := range tests {
+		if got := tt.lang.Date(date); got != tt.want {
+			t.Errorf("%s.Date() = %q, want %q", tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestDuration(t *testing.T) {
+	d := time.Hour + 5*time.Minute + 9*time.Second
+	tests := []struct {
+		lang       Lang
+		short      string
+		long       string
+		minuteOnly string
+	}{
+		{Indonesian, "1h 05m", "1h 05m 09s", "12m"},
+		{English, "1h 05m", "1h 05m 09s", "12m"},
+		{Malay, "1j 05m", "1j 05m 09s", "12m"},
+		{Arabic, "1 س 05 د", "1 س 05 د 09 ث", "12 د"},
+	}
+	for _, tt := range tests {
+		if got := tt.lang.Duration(d); got != tt.short {
+			t.Errorf("%s.Duration = %q, want %q", tt.lang, got, tt.short)
+		}
+		if got := tt.lang.LongDuration(d); got != tt.long {
+			t.Errorf("%s.LongDuration = %q, want %q", tt.lang, got, tt.long)
+		}
+		if got := tt.lang.Duration(12*time.Minute + 30*time.Second); got != tt.minuteOnly {
+			t.Errorf("%s.Duration of 12m30s = %q, want %q", tt.lang, got, tt.minuteOnly)
+		}
+	}
+}
+
+func TestPasaran(t *testing.T) {
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		{time.Date(1945, time.August, 17, 10, 0, 0, 0, time.UTC), "Legi"},
+		{time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC), "Wage"},
+		{time.Date(2024, time.January, 1, 23, 59, 0, 0, time.UTC), "Pahing"},
+		{time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC), "Pon"},
+	}
+	for _, tt := range tests {
+		if got := Pasaran(tt.date); got != tt.want {
+			t.Errorf("Pasaran(%s) = %q, want %q", tt.date.Format("2006-01-02"), got, tt.want)
+		}
+	}
+
+	if got, want := Indonesian.PasaranDate(time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)), "Senin Pahing, 11 Maret 2024"; got != want {
+		t.Errorf("PasaranDate() = %q, want %q", got, want)
+	}
+}
+
+func TestClock(t *testing.T) {
+	afternoon := time.Date(2024, time.March, 11, 15, 4, 5, 0, time.UTC)
+	midnight := time.Date(2024, time.March, 11, 0, 30, 0, 0, time.UTC)
+	tests := []struct {
+		lang Lang
+		t    time.Time
+		f    ClockFormat
+		want string
+	}{
+		{Indonesian, afternoon, ClockFormat{}, "15:04"},
+		{Indonesian, afternoon, ClockFormat{Seconds: true}, "15:04:05"},
+		{English, afternoon, ClockFormat{Hour12: true}, "3:04 PM"},
+		{English, afternoon, ClockFormat{Hour12: true, Seconds: true}, "3:04:05 PM"},
+		{English, midnight, ClockFormat{Hour12: true}, "12:30 AM"},
+		{Malay, afternoon, ClockFormat{Hour12: true}, "3:04 PTG"},
+	}
+	for _, tt := range tests {
+		if got := tt.lang.Clock(tt.t, tt.f); got != tt.want {
+			t.Errorf("%s.Clock(%+v) = %q, want %q", tt.lang, tt.f, got, tt.want)
+		}
+	}
+}
+
+// dynamicKeys are messages translated through variables rather than
+// literals: prayer names and the Hijri months of package salat
+var dynamicKeys = []string{
+	"Imsak", "Subuh", "Dzuhur", "Ashar", "Maghrib", "Isya",
+	"Muharram", "Safar", "Rabiul Awal", "Rabiul Akhir", "Jumadil Awal", "Jumadil Akhir",
+	"Rajab", "Sya'ban", "Ramadhan", "Syawal", "Dzulqa'dah", "Dzulhijjah",
+}
+
+// sourceKeys returns every message passed as a literal to T, Sprintf, or
+// Errorf in the module
+func sourceKeys(t *testing.T) map[string]bool {
+	keys := map[string]bool{}
+	for _, k := range dynamicKeys {
+		keys[k] = true
+	}
+	for _, names := range [][]string{weekdays[:], shortWeekdays[:], months[:], shortMonths[:]} {
+		for _, k := range names {
+			keys[k] = true
+		}
+	}
+
+	err := filepath.WalkDir("..", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() && path != ".." && strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			var name string
+			switch fn := call.Fun.(type) {
+			case *ast.Ident:
+				if f.Name.Name == "i18n" {
+					name = fn.Name
+				}
+			case *ast.SelectorExpr:
+				if x, ok := fn.X.(*ast.Ident); !ok || x.Name != "fmt" {
+					name = fn.Sel.Name
+				}
+			}
+			if name != "T" && name != "Sprintf" && name != "Errorf" {
+				return true
+			}
+			if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				s, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					t.Fatalf("%s: %v", path, err)
+				}
+				keys[s] = true
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keys
+}
+
+var verbPattern = regexp.MustCompile(`%(\[\d+\])?[-+# 0]*\d*(\.\d+)?[a-zA-Z%]`)
+
+// verbs returns the formatting verbs of a message, without argument indexes
+func verbs(msg string) []string {
+	var list []string
+	for _, v := range verbPattern.FindAllStringSubmatch(msg, -1) {
+		list = append(list, strings.Replace(v[0], v[1], "", 1))
+	}
+	sort.Strings(list)
+	return list
+}
+
+func TestCatalogs(t *testing.T) {
+	keys := sourceKeys(t)
+	for _, l := range Languages {
+		catalog, ok := catalogs[l]
+		if l == Default {
+			if ok {
+				t.Errorf("the source language %s has a catalog", l)
+			}
+			continue
+		}
+		for key := range keys {
+			translated, ok := catalog[key]
+			if !ok {
+				t.Errorf("%s: missing translation of %q", l, key)
+				continue
+			}
+			if got, want := verbs(translated), verbs(key); strings.Join(got, " ") != strings.Join(want, " ") {
+				t.Errorf("%s: translation of %q has verbs %v, want %v", l, key, got, want)
+			}
+		}
+		for key := range catalog {
+			if !keys[key] {
+				t.Errorf("%s: translation of unused message %q", l, key)
+			}
+		}
+	}
+}
+
+func TestStripEmoji(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"🕌 Jadwal Sholat", "Jadwal Sholat"},
+		{"⚠️  Peringatan: %s", "Peringatan: %s"},
+		{"│ 🔔 WAKTU SHOLAT %s TELAH TIBA! │", "│ WAKTU SHOLAT %s TELAH TIBA! │"},
+		{"Subuh ✓", "Subuh ✓"},
+		{"%s%s pukul %s", "%s%s pukul %s"},
+	}
+	for _, tt := range tests {
+		if got := StripEmoji(tt.in); got != tt.want {
+			t.Errorf("StripEmoji(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
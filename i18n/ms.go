@@ -0,0 +1,436 @@
+package i18n
+
+// malay translates the Indonesian messages to Malay
+var malay = map[string]string{
+	// Prayer names
+	"Imsak":   "Imsak",
+	"Subuh":   "Subuh",
+	"Dzuhur":  "Zohor",
+	"Ashar":   "Asar",
+	"Maghrib": "Maghrib",
+	"Isya":    "Isyak",
+
+	// Weekdays
+	"Minggu": "Ahad",
+	"Senin":  "Isnin",
+	"Selasa": "Selasa",
+	"Rabu":   "Rabu",
+	"Kamis":  "Khamis",
+	"Jumat":  "Jumaat",
+	"Sabtu":  "Sabtu",
+	"Min":    "Ahd",
+	"Sen":    "Isn",
+	"Sel":    "Sel",
+	"Rab":    "Rab",
+	"Kam":    "Kha",
+	"Jum":    "Jum",
+	"Sab":    "Sab",
+
+	// Months
+	"Januari":   "Januari",
+	"Februari":  "Februari",
+	"Maret":     "Mac",
+	"April":     "April",
+	"Mei":       "Mei",
+	"Juni":      "Jun",
+	"Juli":      "Julai",
+	"Agustus":   "Ogos",
+	"September": "September",
+	"Oktober":   "Oktober",
+	"November":  "November",
+	"Desember":  "Disember",
+	"Jan":       "Jan",
+	"Feb":       "Feb",
+	"Mar":       "Mac",
+	"Apr":       "Apr",
+	"Jun":       "Jun",
+	"Jul":       "Jul",
+	"Agu":       "Ogo",
+	"Sep":       "Sep",
+	"Okt":       "Okt",
+	"Nov":       "Nov",
+	"Des":       "Dis",
+
+	// Hijri months
+	"Muharram":      "Muharam",
+	"Safar":         "Safar",
+	"Rabiul Awal":   "Rabiulawal",
+	"Rabiul Akhir":  "Rabiulakhir",
+	"Jumadil Awal":  "Jamadilawal",
+	"Jumadil Akhir": "Jamadilakhir",
+	"Rajab":         "Rejab",
+	"Sya'ban":       "Syaaban",
+	"Ramadhan":      "Ramadan",
+	"Syawal":        "Syawal",
+	"Dzulqa'dah":    "Zulkaedah",
+	"Dzulhijjah":    "Zulhijah",
+
+	// Formats shared by dates and labels
+	"%s, %d %s %d":    "%s, %d %s %d",
+	"%d %s %d":        "%d %s %d",
+	"%d %s":           "%d %s",
+	"%d %s %d H":      "%d %s %d H",
+	"%s (besok)":      "%s (esok)",
+	"%s (hari ini)":   "%s (hari ini)",
+	"%.1f%% selesai":  "%.1f%% selesai",
+	"%dm":             "%dm",
+	"%dh %02dm":       "%dj %02dm",
+	"%dm %02ds":       "%dm %02ds",
+	"%dh %02dm %02ds": "%dj %02dm %02ds",
+	"Error: %v":       "Ralat: %v",
+	"Peringatan: %v":  "Amaran: %v",
+	"%v\nJalankan '%s --help' untuk melihat penggunaan.":                                           "%v\nJalankan '%s --help' untuk melihat penggunaan.",
+	"perintah tidak dikenal %q untuk %q":                                                           "arahan tidak dikenali %q untuk %q",
+	"Mungkin maksud Anda: %s":                                                                      "Mungkin maksud anda: %s",
+	"tidak dapat membaca file konfigurasi %s: %v":                                                  "tidak dapat membaca fail konfigurasi %s: %v",
+	"Perbaiki dengan 'salat config edit', atau jalankan 'salat doctor' untuk pemeriksaan lengkap.": "Betulkan dengan 'salat config edit', atau jalankan 'salat doctor' untuk pemeriksaan penuh.",
+	"Periksa nilai di file konfigurasi dan variabel lingkungan SALAT_*, atau jalankan 'salat doctor' untuk pemeriksaan lengkap.": "Semak nilai dalam fail konfigurasi dan pemboleh ubah persekitaran SALAT_*, atau jalankan 'salat doctor' untuk pemeriksaan penuh.",
+	"bahasa tidak didukung: %q (pilih %s)": "bahasa tidak disokong: %q (pilih %s)",
+
+	// show, now, next, watch, world
+	"AKTIF":                              "AKTIF",
+	"WAKTU":                              "WAKTU",
+	"JAM":                                "MASA",
+	"STATUS":                             "STATUS",
+	"Berikutnya":                         "Seterusnya",
+	"Dalam":                              "Dalam",
+	"Saat ini":                           "Semasa",
+	"Jam setempat":                       "Waktu tempatan",
+	"Tanggal":                            "Tarikh",
+	"Jadwal Sholat":                      "Waktu Solat",
+	"🕌 Jadwal Sholat":                    "🕌 Waktu Solat",
+	"🕌 Jadwal Sholat - %s":               "🕌 Waktu Solat - %s",
+	"🕌 JADWAL SHOLAT LIVE":               "🕌 WAKTU SOLAT LANGSUNG",
+	"🌍 Jadwal Sholat Dunia":              "🌍 Waktu Solat Dunia",
+	"Jadwal Sholat %s - %s":              "Waktu Solat %s - %s",
+	"Jadwal Sholat %s - %s s/d %s":       "Waktu Solat %s - %s hingga %s",
+	"Waktu Sholat Hari Ini:":             "Waktu Solat Hari Ini:",
+	"Waktu sholat saat ini: %s%s":        "Waktu solat semasa: %s%s",
+	"Tidak ada waktu sholat saat ini":    "Tiada waktu solat semasa",
+	"Sholat berikutnya: %s%s":            "Solat seterusnya: %s%s",
+	"⏰ Sholat berikutnya: %s%s dalam %s": "⏰ Solat seterusnya: %s%s dalam %s",
+	"⏱️ Sholat berikutnya: %s%s dalam %s": "⏱️ Solat seterusnya: %s%s dalam %s",
+	"%s%s pukul %s":              "%s%s pada %s",
+	"Countdown: %s":              "Kiraan detik: %s",
+	"Waktu: %s":                  "Masa: %s",
+	"Waktu: %s (dalam %s)":       "Masa: %s (dalam %s)",
+	"dalam %s":                   "dalam %s",
+	"Dimulai: %s (%s yang lalu)": "Bermula: %s (%s yang lalu)",
+	"│ 🔔 WAKTU SHOLAT %s TELAH TIBA! │": "│ 🔔 WAKTU SOLAT %s TELAH MASUK! │",
+	"Keluar dari mode watch...":         "Keluar dari mod pantau...",
+	"📍 Lokasi: %s":                      "📍 Lokasi: %s",
+	"📍 Koordinat: %.6f, %.6f":           "📍 Koordinat: %.6f, %.6f",
+	"🕐 Timezone: %s":                    "🕐 Zon waktu: %s",
+	"🧮 Metode: %s":                      "🧮 Kaedah: %s",
+	"Semua waktu dalam %s (%s)":         "Semua waktu dalam %s (%s)",
+	"Lokasi Anda":                       "Lokasi anda",
+	"Lokasi (%.4f, %.4f)":               "Lokasi (%.4f, %.4f)",
+	"Lokasi (%.6f, %.6f)":               "Lokasi (%.6f, %.6f)",
+	"Waktu sholat ini tidak terjadi di lokasi tersebut pada tanggal ini.": "Waktu solat ini tidak berlaku di lokasi tersebut pada tarikh ini.",
+	"tidak terdefinisi":                           "tidak ditentukan",
+	"waktu sholat tidak terdefinisi":              "waktu solat tidak ditentukan",
+	"%w: %s di lintang %.4f pada %s":              "%w: %s pada latitud %.4f pada %s",
+	"gagal menghitung jadwal sholat: %w":          "gagal mengira waktu solat: %w",
+	"gagal menghitung jadwal sholat untuk %s: %w": "gagal mengira waktu solat untuk %s: %w",
+	"gagal membaca file lokasi: %v":               "gagal membaca fail lokasi: %v",
+	"tidak ada lokasi: gunakan --location, buat file %s, atau tambahkan profil dengan 'salat profile add'": "tiada lokasi: gunakan --location, cipta fail %s, atau tambah profil dengan 'salat profile add'",
+	"timezone tidak valid %s: %v":            "zon waktu tidak sah %s: %v",
+	"timezone tidak valid: %v":               "zon waktu tidak sah: %v",
+	"timezone tidak valid: %s":               "zon waktu tidak sah: %s",
+	"timezone wajib diisi":                   "zon waktu wajib diisi",
+	"gagal mendeteksi timezone untuk %q: %v": "gagal mengesan zon waktu untuk %q: %v",
+	"gagal mendeteksi timezone: %v":          "gagal mengesan zon waktu: %v",
+
+	// kiosk and tui
+	"Adzan %s":                     "Azan %s",
+	"Iqamah %s":                    "Iqamah %s",
+	"--iqamah tidak boleh negatif": "--iqamah tidak boleh negatif",
+	"--iqamah-for tidak valid: %s=%d (gunakan subuh, dzuhur, ashar, maghrib, atau isya)": "--iqamah-for tidak sah: %s=%d (gunakan subuh, dzuhur, ashar, maghrib, atau isya)",
+	"salat kiosk membutuhkan terminal":                                                   "salat kiosk memerlukan terminal",
+	"salat tui membutuhkan terminal, gunakan 'salat show' untuk output biasa":            "salat tui memerlukan terminal, gunakan 'salat show' untuk output biasa",
+	"gagal menjalankan kiosk: %v":                                                        "gagal menjalankan kiosk: %v",
+	"gagal menjalankan dashboard: %v":                                                    "gagal menjalankan papan pemuka: %v",
+	"←/→ hari • [/] bulan • t hari ini • v tampilan hari/bulan • m metode • q keluar":    "←/→ hari • [/] bulan • t hari ini • v paparan hari/bulan • m kaedah • q keluar",
+	"←→ hari • [] bulan • t • v • m • q":                                                 "←→ hari • [] bulan • t • v • m • q",
+
+	// digest
+	"✅ Digest berhasil dikirim!":               "✅ Ringkasan berjaya dihantar!",
+	"📧 Mengirim digest ke %d penerima...":      "📧 Menghantar ringkasan kepada %d penerima...",
+	"tidak ada penerima digest":                "tiada penerima ringkasan",
+	"smtp.host belum dikonfigurasi":            "smtp.host belum dikonfigurasi",
+	"smtp.from belum dikonfigurasi":            "smtp.from belum dikonfigurasi",
+	"gagal menyusun HTML digest: %v":           "gagal menyusun HTML ringkasan: %v",
+	"gagal menyusun email: %v":                 "gagal menyusun e-mel: %v",
+	"gagal mengirim email: %v":                 "gagal menghantar e-mel: %v",
+	"tidak dapat terhubung ke server SMTP: %v": "tidak dapat menyambung ke pelayan SMTP: %v",
+	"tidak dapat memulai sesi SMTP: %v":        "tidak dapat memulakan sesi SMTP: %v",
+	"STARTTLS gagal: %v":                       "STARTTLS gagal: %v",
+	"autentikasi SMTP gagal: %v":               "pengesahan SMTP gagal: %v",
+	"SMTP MAIL FROM ditolak: %v":               "SMTP MAIL FROM ditolak: %v",
+	"SMTP RCPT TO %s ditolak: %v":              "SMTP RCPT TO %s ditolak: %v",
+	"SMTP DATA gagal: %v":                      "SMTP DATA gagal: %v",
+	"tidak dapat menulis pesan: %v":            "tidak dapat menulis mesej: %v",
+	"server SMTP menolak pesan: %v":            "pelayan SMTP menolak mesej: %v",
+
+	// setup and location
+	"Selamat datang di setup salat CLI!":                                                      "Selamat datang ke persediaan salat CLI!",
+	"Konfigurasi belum lengkap. Menjalankan setup...":                                         "Konfigurasi belum lengkap. Menjalankan persediaan...",
+	"Konfigurasi berhasil disimpan!":                                                          "Konfigurasi berjaya disimpan!",
+	"✅ Konfigurasi berhasil disimpan!":                                                        "✅ Konfigurasi berjaya disimpan!",
+	"Jalankan 'salat show' untuk melihat jadwal sholat hari ini.":                             "Jalankan 'salat show' untuk melihat waktu solat hari ini.",
+	"Jalankan 'salat setup' untuk mengatur lokasi.":                                           "Jalankan 'salat setup' untuk menetapkan lokasi.",
+	"🔍 Mencari koordinat lokasi...":                                                           "🔍 Mencari koordinat lokasi...",
+	"🔍 Mencari nama lokasi...":                                                                "🔍 Mencari nama lokasi...",
+	"📍 Lokasi ditemukan: %s":                                                                  "📍 Lokasi ditemui: %s",
+	"🌍 Mengatur lokasi: %s":                                                                   "🌍 Menetapkan lokasi: %s",
+	"Ditemukan %d lokasi untuk %q, pilih salah satu:":                                         "%d lokasi ditemui untuk %q, pilih satu:",
+	"lokasi %q cocok dengan %d tempat:":                                                       "lokasi %q sepadan dengan %d tempat:",
+	"gunakan nama yang lebih spesifik atau --first untuk memakai hasil teratas":               "gunakan nama yang lebih khusus atau --first untuk memakai hasil teratas",
+	"Masukkan latitude lokasi Anda:":                                                          "Masukkan latitud lokasi anda:",
+	"Masukkan longitude lokasi Anda:":                                                         "Masukkan longitud lokasi anda:",
+	"Masukkan timezone (contoh: Asia/Jakarta):":                                               "Masukkan zon waktu (contoh: Asia/Kuala_Lumpur):",
+	"Timezone lokasi: %s. Ubah?":                                                              "Zon waktu lokasi: %s. Ubah?",
+	"Pilih metode perhitungan waktu sholat:":                                                  "Pilih kaedah pengiraan waktu solat:",
+	"gagal membaca input latitude: %v":                                                        "gagal membaca input latitud: %v",
+	"gagal membaca input longitude: %v":                                                       "gagal membaca input longitud: %v",
+	"gagal membaca input timezone: %v":                                                        "gagal membaca input zon waktu: %v",
+	"gagal membaca konfirmasi timezone: %v":                                                   "gagal membaca pengesahan zon waktu: %v",
+	"gagal membaca pilihan lokasi: %v":                                                        "gagal membaca pilihan lokasi: %v",
+	"gagal membaca pilihan metode: %v":                                                        "gagal membaca pilihan kaedah: %v",
+	"lokasi wajib diberikan dalam mode non-interaktif, contoh: salat setup \"Jakarta\" --yes": "lokasi wajib diberikan dalam mod bukan interaktif, contoh: salat setup \"Jakarta\" --yes",
+	"Peringatan: tidak dapat menentukan nama lokasi: %v":                                      "Amaran: tidak dapat menentukan nama lokasi: %v",
+	"⚠️  Peringatan: timezone %s tidak sesuai dengan lokasi (%s)":                             "⚠️  Amaran: zon waktu %s tidak sepadan dengan lokasi (%s)",
+	"   Jalankan 'salat config set timezone %s' untuk menyesuaikan.":                          "   Jalankan 'salat config set timezone %s' untuk membetulkannya.",
+	"Lokasi diperbarui: %s":                                                                   "Lokasi dikemas kini: %s",
+	"Timezone diperbarui: %s (sebelumnya %s)":                                                 "Zon waktu dikemas kini: %s (sebelum ini %s)",
+	"Lokasi diatur ke: %s (%.6f, %.6f)":                                                       "Lokasi ditetapkan kepada: %s (%.6f, %.6f)",
+	"tidak dapat menemukan lokasi %q: %w":                                                     "tidak dapat mencari lokasi %q: %w",
+	"tidak dapat menemukan lokasi: %w":                                                        "tidak dapat mencari lokasi: %w",
+	"gagal mencari lokasi: %w":                                                                "gagal mencari lokasi: %w",
+
+	// config
+	"Konfigurasi saat ini:":                            "Konfigurasi semasa:",
+	"  profil: %s":                                     "  profil: %s",
+	"  lokasi: %s":                                     "  lokasi: %s",
+	"Timezone diatur ke: %s":                           "Zon waktu ditetapkan kepada: %s",
+	"Latitude diatur ke: %.6f":                         "Latitud ditetapkan kepada: %.6f",
+	"Longitude diatur ke: %.6f":                        "Longitud ditetapkan kepada: %.6f",
+	"Koordinat diatur ke: %.6f, %.6f":                  "Koordinat ditetapkan kepada: %.6f, %.6f",
+	"Metode perhitungan diatur ke: %s":                 "Kaedah pengiraan ditetapkan kepada: %s",
+	"Metode perhitungan diatur ke: auto (saat ini %s)": "Kaedah pengiraan ditetapkan kepada: auto (kini %s)",
+	"Geocoding API diatur ke: %s":                      "API geokod ditetapkan kepada: %s",
+	"Geocoding URL diatur ke: %s":                      "URL geokod ditetapkan kepada: %s",
+	"Email kontak geocoding diatur ke: %s":             "E-mel hubungan geokod ditetapkan kepada: %s",
+	"Cache geocoding berlaku selama: %s":               "Cache geokod sah selama: %s",
+	"Cache geocoding dinonaktifkan":                    "Cache geokod dinyahaktifkan",
+	"Penyesuaian %s diatur ke: %+d menit":              "Pelarasan %s ditetapkan kepada: %+d minit",
+	"SMTP host diatur ke: %s":                          "Hos SMTP ditetapkan kepada: %s",
+	"SMTP port diatur ke: %d":                          "Port SMTP ditetapkan kepada: %d",
+	"SMTP username diatur ke: %s":                      "Nama pengguna SMTP ditetapkan kepada: %s",
+	"SMTP password diatur":                             "Kata laluan SMTP ditetapkan",
+	"Pengirim email diatur ke: %s":                     "Penghantar e-mel ditetapkan kepada: %s",
+	"Penerima digest diatur ke: %s":                    "Penerima ringkasan ditetapkan kepada: %s",
+	"API tidak valid. Pilih salah satu dari: %s":       "API tidak sah. Pilih salah satu daripada: %s",
+	"URL geocoding tidak valid: %s":                    "URL geokod tidak sah: %s",
+	"durasi cache tidak valid (contoh: 720h, atau 0 untuk menonaktifkan): %s": "tempoh cache tidak sah (contoh: 720h, atau 0 untuk menyahaktifkan): %s",
+	"penyesuaian harus berupa jumlah menit (contoh: 2 atau -3): %s":           "pelarasan mesti dalam bilangan minit (contoh: 2 atau -3): %s",
+	"port SMTP tidak valid: %s": "port SMTP tidak sah: %s",
+	"metode tidak valid: %s":    "kaedah tidak sah: %s",
+	"metode tidak valid. Pilih salah satu dari: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto":     "kaedah tidak sah. Pilih salah satu daripada: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto",
+	"metode tidak valid: %s. Pilih salah satu dari: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto": "kaedah tidak sah: %s. Pilih salah satu daripada: MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM, auto",
+	"latitude tidak valid: %v":        "latitud tidak sah: %v",
+	"longitude tidak valid: %v":       "longitud tidak sah: %v",
+	"nilai latitude tidak valid: %v":  "nilai latitud tidak sah: %v",
+	"nilai longitude tidak valid: %v": "nilai longitud tidak sah: %v",
+	"koordinat tidak valid: %v":       "koordinat tidak sah: %v",
+	"koordinat tidak valid: %v, %v":   "koordinat tidak sah: %v, %v",
+	"koordinat di luar jangkauan: %.6f, %.6f (latitude -90..90, longitude -180..180)":             "koordinat di luar julat: %.6f, %.6f (latitud -90..90, longitud -180..180)",
+	"koordinat di luar jangkauan: %f, %f":                                                         "koordinat di luar julat: %f, %f",
+	"timezone tidak dikenal: %s (gunakan nama IANA seperti Asia/Jakarta)":                         "zon waktu tidak dikenali: %s (gunakan nama IANA seperti Asia/Kuala_Lumpur)",
+	"lokasi tidak bisa dikosongkan, ubah dengan 'salat config set location' atau 'salat setup'":   "lokasi tidak boleh dikosongkan, ubah dengan 'salat config set location' atau 'salat setup'",
+	"gagal menyimpan konfigurasi: %v":                                                             "gagal menyimpan konfigurasi: %v",
+	"gagal membaca konfigurasi: %v":                                                               "gagal membaca konfigurasi: %v",
+	"gagal membaca konfigurasi: %v (gunakan --replace untuk menggantinya, cadangan tetap dibuat)": "gagal membaca konfigurasi: %v (gunakan --replace untuk menggantikannya, sandaran tetap dibuat)",
+	"gagal memuat konfigurasi: %v":                                                                "gagal memuatkan konfigurasi: %v",
+	"konfigurasi lokasi belum ada":                                                                "konfigurasi lokasi belum ada",
+	"Konfigurasi tidak valid: %v":                                                                 "Konfigurasi tidak sah: %v",
+	"Perbaiki dengan 'salat config set', atau jalankan 'salat doctor' untuk pemeriksaan lengkap.": "Betulkan dengan 'salat config set', atau jalankan 'salat doctor' untuk pemeriksaan penuh.",
+	"%s dikembalikan ke default":                                                                  "%s dikembalikan kepada lalai",
+	"berikan kunci konfigurasi atau --all":                                                        "berikan kunci konfigurasi atau --all",
+	"Belum ada file konfigurasi, tidak ada yang direset.":                                         "Belum ada fail konfigurasi, tiada apa yang ditetapkan semula.",
+	"Konfigurasi direset. Cadangan: %s":                                                           "Konfigurasi ditetapkan semula. Sandaran: %s",
+	"Cadangan konfigurasi lama: %s":                                                               "Sandaran konfigurasi lama: %s",
+	"Konfigurasi diekspor ke %s":                                                                  "Konfigurasi dieksport ke %s",
+	"Konfigurasi diimpor ke %s":                                                                   "Konfigurasi diimport ke %s",
+	"Konfigurasi diperbarui ke versi %d (cadangan: %s)":                                           "Konfigurasi dikemas kini ke versi %d (sandaran: %s)",
+	"Tidak ada perubahan.":                                                                        "Tiada perubahan.",
+	"Perubahan dibatalkan, konfigurasi tidak diubah.":                                             "Perubahan dibatalkan, konfigurasi tidak diubah.",
+	"Edit lagi? (Tidak = batalkan perubahan)":                                                     "Sunting lagi? (Tidak = batalkan perubahan)",
+	"⚠️  Nilai konfigurasi berikut masih tidak valid:":                                            "⚠️  Nilai konfigurasi berikut masih tidak sah:",
+	"belum ada file konfigurasi di %s":                                                            "belum ada fail konfigurasi di %s",
+	"format tidak valid: %s (pilih yaml atau json)":                                               "format tidak sah: %s (pilih yaml atau json)",
+	"file impor tidak valid: %v":                                                                  "fail import tidak sah: %v",
+	"gagal membuka file impor: %v":                                                                "gagal membuka fail import: %v",
+	"gagal membuat file ekspor: %v":                                                               "gagal mencipta fail eksport: %v",
+	"gagal mengekspor konfigurasi: %v":                                                            "gagal mengeksport konfigurasi: %v",
+	"gagal menghapus konfigurasi: %v":                                                             "gagal memadam konfigurasi: %v",
+	"gagal menjalankan editor %s: %v":                                                             "gagal menjalankan penyunting %s: %v",
+	"gagal membaca file konfigurasi: %v":                                                          "gagal membaca fail konfigurasi: %v",
+	"gagal membaca file konfigurasi yang diperbarui: %v":                                          "gagal membaca fail konfigurasi yang dikemas kini: %v",
+	"gagal menulis file konfigurasi yang diperbarui: %v":                                          "gagal menulis fail konfigurasi yang dikemas kini: %v",
+	"gagal membuat cadangan konfigurasi: %v":                                                      "gagal membuat sandaran konfigurasi: %v",
+	"gagal mencadangkan file konfigurasi: %v":                                                     "gagal membuat sandaran fail konfigurasi: %v",
+	"gagal memperbarui konfigurasi ke versi %d: %v":                                               "gagal mengemas kini konfigurasi ke versi %d: %v",
+	"versi konfigurasi %d lebih baru dari yang didukung salat ini (%d), perbarui salat":           "versi konfigurasi %d lebih baharu daripada yang disokong salat ini (%d), kemas kini salat",
+	"versi konfigurasi tidak valid: %v":                                                           "versi konfigurasi tidak sah: %v",
+	"tidak dapat membaca isi konfigurasi: %v":                                                     "tidak dapat membaca kandungan konfigurasi: %v",
+	"profiles harus berupa map, bukan %T":                                                         "profiles mesti berupa map, bukan %T",
+	"Bahasa diatur ke: %s":                                                                        "Bahasa ditetapkan kepada: %s",
+	"kunci konfigurasi tidak valid. Pilih salah satu dari: timezone, location, latitude, longitude, method, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, adjustments.<waktu>, smtp.host, smtp.port, smtp.username, smtp.password, smtp.from, digest.recipients, display.no_color, display.no_emoji, display.screen_reader, display.theme, display.clock, display.seconds, display.pasaran, themes.<nama>.<bagian>, formats.<nama>": "kunci konfigurasi tidak sah. Pilih salah satu daripada: timezone, location, latitude, longitude, method, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, adjustments.<waktu>, smtp.host, smtp.port, smtp.username, smtp.password, smtp.from, digest.recipients, display.no_color, display.no_emoji, display.screen_reader, display.theme, display.clock, display.seconds, display.pasaran, themes.<nama>.<bahagian>, formats.<nama>",
+	"kunci konfigurasi tidak valid: %s. Pilih salah satu dari: method, adjustments, adjustments.<waktu>, active_profile, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, smtp, smtp.<kunci>, digest.recipients, display, display.<kunci>, themes, themes.<nama>, themes.<nama>.<bagian>, formats, formats.<nama>":                                                                                                                     "kunci konfigurasi tidak sah: %s. Pilih salah satu daripada: method, adjustments, adjustments.<waktu>, active_profile, language, geocoding_api, geocoding_url, geocoding_email, geocoding_cache_ttl, smtp, smtp.<kunci>, digest.recipients, display, display.<kunci>, themes, themes.<nama>, themes.<nama>.<bahagian>, formats, formats.<nama>",
+
+	// validation
+	"%s: %s (nilai: %v)":                                             "%s: %s (nilai: %v)",
+	"konfigurasi tidak valid:":                                       "konfigurasi tidak sah:",
+	"lebih baru dari yang didukung salat ini (%d)":                   "lebih baharu daripada yang disokong salat ini (%d)",
+	"harus salah satu dari %s":                                       "mesti salah satu daripada %s",
+	"harus salah satu dari %s, auto":                                 "mesti salah satu daripada %s, auto",
+	"tidak ada profil dengan nama ini":                               "tiada profil dengan nama ini",
+	"harus berupa URL http atau https":                               "mesti berupa URL http atau https",
+	"harus berupa durasi seperti 720h, atau 0 untuk menonaktifkan":   "mesti berupa tempoh seperti 720h, atau 0 untuk menyahaktifkan",
+	"harus di antara 1 dan 65535":                                    "mesti di antara 1 dan 65535",
+	"harus di antara -90 dan 90":                                     "mesti di antara -90 dan 90",
+	"harus di antara -180 dan 180":                                   "mesti di antara -180 dan 180",
+	"harus di antara -%d dan %d menit":                               "mesti di antara -%d dan %d minit",
+	"harus berupa alamat email":                                      "mesti berupa alamat e-mel",
+	"wajib diisi":                                                    "wajib diisi",
+	"timezone tidak dikenal, gunakan nama IANA seperti Asia/Jakarta": "zon waktu tidak dikenali, gunakan nama IANA seperti Asia/Kuala_Lumpur",
+	"gunakan huruf kecil, angka, '-' dan '_'":                        "gunakan huruf kecil, nombor, '-' dan '_'",
+	"geocoding_cache_ttl tidak valid %q: gunakan durasi seperti 720h, atau 0 untuk menonaktifkan": "geocoding_cache_ttl tidak sah %q: gunakan tempoh seperti 720h, atau 0 untuk menyahaktifkan",
+
+	// profiles
+	"Belum ada profil. Tambahkan dengan 'salat profile add [nama] [lokasi]'.": "Belum ada profil. Tambah dengan 'salat profile add [nama] [lokasi]'.",
+	"Profil %s dihapus":                                                   "Profil %s dipadam",
+	"Profil aktif: %s (%s)":                                               "Profil aktif: %s (%s)",
+	"✅ Profil %s ditambahkan: %s (%.6f, %.6f) • %s":                       "✅ Profil %s ditambah: %s (%.6f, %.6f) • %s",
+	"Jalankan 'salat profile use %s' untuk memakainya.":                   "Jalankan 'salat profile use %s' untuk menggunakannya.",
+	"profil %q sudah ada, hapus dulu dengan 'salat profile remove %s'":    "profil %q sudah wujud, padam dahulu dengan 'salat profile remove %s'",
+	"profil %q tidak ditemukan":                                           "profil %q tidak ditemui",
+	"profil default tidak bisa dihapus, ubah dengan 'salat setup'":        "profil lalai tidak boleh dipadam, ubah dengan 'salat setup'",
+	"nama profil tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'": "nama profil tidak sah %q: gunakan huruf kecil, nombor, '-' dan '_'",
+
+	// gazetteer and geocoding
+	"✅ %d lokasi diimpor ke %s":                          "✅ %d lokasi diimport ke %s",
+	"⬇️  Mengunduh %s...":                                "⬇️  Memuat turun %s...",
+	"server mengembalikan status %d":                     "pelayan memulangkan status %d",
+	"API geocoding mengembalikan status %d":              "API geokod memulangkan status %d",
+	"gagal membaca gazetteer: %v":                        "gagal membaca gazetir: %v",
+	"gagal memuat gazetteer: %v":                         "gagal memuatkan gazetir: %v",
+	"gagal menyimpan gazetteer: %v":                      "gagal menyimpan gazetir: %v",
+	"gazetteer tidak valid: %v":                          "gazetir tidak sah: %v",
+	"%s: kolom yang diharapkan %s":                       "%s: lajur yang dijangka %s",
+	"%s: latitude tidak valid %q untuk %s":               "%s: latitud tidak sah %q untuk %s",
+	"%s: longitude tidak valid %q untuk %s":              "%s: longitud tidak sah %q untuk %s",
+	"lokasi %q tidak ditemukan di gazetteer (%d lokasi)": "lokasi %q tidak ditemui dalam gazetir (%d lokasi)",
+	"latitude dari API tidak valid: %v":                  "latitud daripada API tidak sah: %v",
+	"longitude dari API tidak valid: %v":                 "longitud daripada API tidak sah: %v",
+	"penyedia geocoding tidak dikenal %q (tersedia: %s)": "penyedia geokod tidak dikenali %q (tersedia: %s)",
+	"tidak ada hasil untuk %s":                           "tiada hasil untuk %s",
+	"gagal memuat batas timezone: %v":                    "gagal memuatkan sempadan zon waktu: %v",
+	"timezone tidak ditemukan untuk %f, %f":              "zon waktu tidak ditemui untuk %f, %f",
+
+	// doctor
+	"🩺 Pemeriksaan salat":                         "🩺 Pemeriksaan salat",
+	"%d pemeriksaan gagal, %d peringatan":         "%d pemeriksaan gagal, %d amaran",
+	"Selesai dengan %d peringatan.":               "Selesai dengan %d amaran.",
+	"Semua pemeriksaan berhasil.":                 "Semua pemeriksaan berjaya.",
+	"Konfigurasi":                                 "Konfigurasi",
+	"Timezone":                                    "Zon waktu",
+	"Geocoding":                                   "Geokod",
+	"Perhitungan":                                 "Pengiraan",
+	"lokasi file konfigurasi tidak diketahui: %v": "lokasi fail konfigurasi tidak diketahui: %v",
+	"file konfigurasi %s belum ada (jalankan 'salat setup')": "fail konfigurasi %s belum ada (jalankan 'salat setup')",
+	"file konfigurasi %s tidak bisa dibaca: %v":              "fail konfigurasi %s tidak dapat dibaca: %v",
+	"file konfigurasi: %s":                                   "fail konfigurasi: %s",
+	"konfigurasi tidak bisa dibaca: %v":                      "konfigurasi tidak boleh dibaca: %v",
+	"semua nilai konfigurasi valid (versi %d)":               "semua nilai konfigurasi sah (versi %d)",
+	"lokasi belum diatur (jalankan 'salat setup')":           "lokasi belum ditetapkan (jalankan 'salat setup')",
+	"profil: %s":                                 "profil: %s",
+	"lokasi: %s (%.6f, %.6f)":                    "lokasi: %s (%.6f, %.6f)",
+	"database timezone tidak tersedia: %v":       "pangkalan data zon waktu tidak tersedia: %v",
+	"database timezone tersedia":                 "pangkalan data zon waktu tersedia",
+	"timezone %q tidak dikenal":                  "zon waktu %q tidak dikenali",
+	"timezone: %s (%s)":                          "zon waktu: %s (%s)",
+	"timezone lokasi tidak dapat ditentukan: %v": "zon waktu lokasi tidak dapat ditentukan: %v",
+	"timezone %s tidak sesuai dengan lokasi (%s), jalankan 'salat config set timezone %s'": "zon waktu %s tidak sepadan dengan lokasi (%s), jalankan 'salat config set timezone %s'",
+	"timezone sesuai dengan lokasi":                                               "zon waktu sepadan dengan lokasi",
+	"direktori konfigurasi tidak ditemukan: %v":                                   "direktori konfigurasi tidak ditemui: %v",
+	"gazetteer offline tidak bisa dibaca: %v":                                     "gazetir luar talian tidak boleh dibaca: %v",
+	"gazetteer offline: %d lokasi":                                                "gazetir luar talian: %d lokasi",
+	"provider: offline, tidak memerlukan internet":                                "penyedia: luar talian, tidak memerlukan internet",
+	"provider %s tidak diperiksa (--offline)":                                     "penyedia %s tidak diperiksa (--offline)",
+	"provider %s tidak dapat dihubungi: %v (geocoding memakai gazetteer offline)": "penyedia %s tidak dapat dihubungi: %v (geokod memakai gazetir luar talian)",
+	"provider %s dapat dihubungi (%d ms)":                                         "penyedia %s boleh dihubungi (%d ms)",
+	"  ⏭️  dilewati karena konfigurasi belum valid":                               "  ⏭️  dilangkau kerana konfigurasi belum sah",
+	"metode: %s":                    "kaedah: %s",
+	"%s (%s) tidak sebelum %s (%s)": "%s (%s) tidak sebelum %s (%s)",
+	"jadwal hari ini berurutan: Subuh %s, Dzuhur %s, Maghrib %s":     "waktu hari ini tersusun: Subuh %s, Zohor %s, Maghrib %s",
+	"Dzuhur %s menyimpang %.0f menit dari tengah hari matahari (%s)": "Zohor %s menyimpang %.0f minit dari tengah hari matahari (%s)",
+	"Dzuhur dalam %.0f menit dari tengah hari matahari":              "Zohor dalam %.0f minit dari tengah hari matahari",
+
+	// formats
+	"gagal menjalankan template: %v":                                        "gagal menjalankan templat: %v",
+	"nama template tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'": "nama templat tidak sah %q: gunakan huruf kecil, nombor, '-' dan '_'",
+	"template tidak valid: %v":                                              "templat tidak sah: %v",
+	"Template %s disimpan, pakai dengan --format %s":                        "Templat %s disimpan, gunakan dengan --format %s",
+
+	// status
+	"gaya tidak dikenal %q, pilih salah satu dari: %s": "gaya tidak dikenali %q, pilih salah satu daripada: %s",
+
+	// prompt
+	"shell tidak dikenal %q, pilih salah satu dari: %s": "shell tidak dikenali %q, pilih salah satu daripada: %s",
+
+	// display
+	"OK:":                                "OK:",
+	"Peringatan:":                        "Amaran:",
+	"Gagal:":                             "Gagal:",
+	"nilai harus true atau false: %s":    "nilai mesti true atau false: %s",
+	"%s diatur ke: %t":                   "%s ditetapkan kepada: %t",
+	"kurang dari satu menit":             "kurang daripada satu minit",
+	"%d menit":                           "%d minit",
+	"%d jam":                             "%d jam",
+	"%d jam %d menit":                    "%d jam %d minit",
+	"Jadwal sholat %s di %s, metode %s.": "Waktu solat %s di %s, kaedah %s.",
+	"%s pukul %s, sedang berlangsung.":   "%s pada pukul %s, sedang berlangsung.",
+	"%s pukul %s, sudah lewat.":          "%s pada pukul %s, sudah berlalu.",
+	"%s pukul %s, %s lagi.":              "%s pada pukul %s, %s lagi.",
+	"%s pukul %s.":                       "%s pada pukul %s.",
+	"Sholat berikutnya %s pukul %s, %s lagi.":          "Solat seterusnya %s pada pukul %s, %s lagi.",
+	"Pukul %s di %s.":                                  "Pukul %s di %s.",
+	"Waktu sholat saat ini %s, dimulai pukul %s.":      "Waktu solat semasa %s, bermula pada pukul %s.",
+	"Saat ini bukan waktu sholat.":                     "Sekarang bukan waktu solat.",
+	"tengah malam":                                     "tengah malam",
+	"%d persen waktu dari %s sampai %s sudah berlalu.": "%d peratus masa dari %s hingga %s telah berlalu.",
+	"Waktu %s telah tiba, pukul %s.":                   "Waktu %s telah masuk, pukul %s.",
+
+	// themes
+	"warna tidak dikenal %q":                                            "warna tidak dikenali %q",
+	"warna atau atribut tidak dikenal %q":                               "warna atau atribut tidak dikenali %q",
+	"nama tema tidak valid %q: gunakan huruf kecil, angka, '-' dan '_'": "nama tema tidak sah %q: gunakan huruf kecil, nombor, '-' dan '_'",
+	"tidak ada tema dengan nama %q":                                     "tiada tema bernama %q",
+	"gunakan themes.<nama>.<bagian>, dengan bagian salah satu dari: %s": "gunakan themes.<nama>.<bahagian>, dengan bahagian salah satu daripada: %s",
+	"gagal membaca tema: %v":                                            "gagal membaca tema: %v",
+	"tema tidak dikenal %q, pilih salah satu dari: %s":                  "tema tidak dikenali %q, pilih salah satu daripada: %s",
+	"Tema diatur ke: %s":                                                "Tema ditetapkan kepada: %s",
+	"Tema %s: %s diatur ke %s, pakai dengan --theme %s":                 "Tema %s: %s ditetapkan kepada %s, gunakan dengan --theme %s",
+	"Judul": "Tajuk",
+	"Lewat": "Berlalu",
+
+	// clock and pasaran
+	"%s %s, %d %s %d":          "%s %s, %d %s %d",
+	"AM":                       "PG",
+	"PM":                       "PTG",
+	"harus 12h atau 24h":       "mesti 12h atau 24h",
+	"Format jam diatur ke: %s": "Format jam ditetapkan kepada: %s",
+	"format jam tidak valid %q, pilih 12h atau 24h": "format jam tidak sah %q, pilih 12h atau 24h",
+}
@@ -2,7 +2,11 @@ package salat
 
 import (
 	"math"
+	"sort"
+	"strings"
 	"time"
+
+	"jadwalsalat/i18n"
 )
 
 // CalculationMethod represents different methods for calculating prayer times
@@ -25,12 +29,119 @@ const (
 	Kemenag CalculationMethod = "Kemenag"
 	// JAKIM - Jabatan Kemajuan Islam Malaysia
 	JAKIM CalculationMethod = "JAKIM"
+	// Auto - the conventional method for the country of the location
+	Auto CalculationMethod = "auto"
 )
 
+// Methods lists the calculation methods that can be configured
+var Methods = []CalculationMethod{MWL, ISNA, Egypt, Makkah, Karachi, Tehran, Kemenag, JAKIM}
+
+// countryMethods maps ISO 3166 country codes to the method conventionally used there
+var countryMethods = map[string]CalculationMethod{
+	"ID": Kemenag,
+	"MY": JAKIM,
+	// MUIS and the Brunei authorities use the same 20°/18° angles as JAKIM
+	"SG": JAKIM,
+	"BN": JAKIM,
+	"SA": Makkah,
+	"AE": Makkah,
+	"BH": Makkah,
+	"KW": Makkah,
+	"OM": Makkah,
+	"QA": Makkah,
+	"YE": Makkah,
+	"US": ISNA,
+	"CA": ISNA,
+	"EG": Egypt,
+	"SD": Egypt,
+	"SS": Egypt,
+	"LY": Egypt,
+	"SY": Egypt,
+	"LB": Egypt,
+	"PK": Karachi,
+	"IN": Karachi,
+	"BD": Karachi,
+	"AF": Karachi,
+	"IR": Tehran,
+}
+
+// MethodForCountry returns the conventional calculation method for an
+// ISO 3166 country code, or MWL when the country has no specific convention
+func MethodForCountry(country string) CalculationMethod {
+	if m, ok := countryMethods[strings.ToUpper(country)]; ok {
+		return m
+	}
+	return MWL
+}
+
+// ErrUndefined is returned when a prayer time does not occur at the location
+// on the date, e.g. when the sun never reaches the Isya angle near the poles
+// in summer
+var ErrUndefined error = undefinedError{}
+
+// undefinedError is the type of ErrUndefined, translated when printed
+type undefinedError struct{}
+
+func (undefinedError) Error() string { return i18n.T("waktu sholat tidak terdefinisi") }
+
 type Location struct {
-	Latitude  float64
-	Longitude float64
-	Method    CalculationMethod
+	Latitude    float64
+	Longitude   float64
+	Method      CalculationMethod
+	Adjustments Adjustments
+}
+
+// Adjustments are minutes added to the calculated prayer times, e.g. to
+// follow the schedule published by the local mosque. Negative values move a
+// time earlier.
+type Adjustments struct {
+	Imsak   int `mapstructure:"imsak"`
+	Subuh   int `mapstructure:"subuh"`
+	Dzuhur  int `mapstructure:"dzuhur"`
+	Ashar   int `mapstructure:"ashar"`
+	Maghrib int `mapstructure:"maghrib"`
+	Isya    int `mapstructure:"isya"`
+}
+
+// AdjustmentNames lists the prayers that can be adjusted, in order
+var AdjustmentNames = []string{"imsak", "subuh", "dzuhur", "ashar", "maghrib", "isya"}
+
+// Get returns the adjustment for a prayer by its lowercase name
+func (a *Adjustments) Get(name string) (int, bool) {
+	p := a.field(name)
+	if p == nil {
+		return 0, false
+	}
+	return *p, true
+}
+
+// Set changes the adjustment for a prayer by its lowercase name
+func (a *Adjustments) Set(name string, minutes int) bool {
+	p := a.field(name)
+	if p == nil {
+		return false
+	}
+	*p = minutes
+	return true
+}
+
+func (a *Adjustments) field(name string) *int {
+	switch strings.ToLower(name) {
+	case "imsak":
+		return &a.Imsak
+	case "subuh":
+		return &a.Subuh
+	case "dzuhur":
+		return &a.Dzuhur
+	case "ashar":
+		return &a.Ashar
+	case "maghrib":
+		return &a.Maghrib
+	case "isya":
+		return &a.Isya
+	default:
+		return nil
+	}
 }
 
 type PrayerTimes struct {
@@ -129,12 +240,15 @@ func calculateSolarPosition(jd float64) (declination, eqOfTime float64) {
 
 	declination = radiansToDegrees(math.Asin(math.Sin(degreesToRadians(e)) * math.Sin(degreesToRadians(L))))
 
+	// Equation of time in minutes; the anomaly terms scale with the
+	// eccentricity of the Earth's orbit
+	ecc := 0.016709 - 0.000000001151*D
 	y := math.Pow(math.Tan(degreesToRadians(e/2.0)), 2)
 	eqOfTime = 4.0 * radiansToDegrees(y*math.Sin(2.0*degreesToRadians(q))-
-		2.0*math.Sin(degreesToRadians(g))+
-		4.0*y*math.Sin(degreesToRadians(g))*math.Cos(2.0*degreesToRadians(q))-
+		2.0*ecc*math.Sin(degreesToRadians(g))+
+		4.0*ecc*y*math.Sin(degreesToRadians(g))*math.Cos(2.0*degreesToRadians(q))-
 		0.5*y*y*math.Sin(4.0*degreesToRadians(q))-
-		1.25*math.Sin(degreesToRadians(2*g)))
+		1.25*ecc*ecc*math.Sin(degreesToRadians(2*g)))
 
 	return declination, eqOfTime
 }
@@ -209,6 +323,18 @@ func TimesForDate(date time.Time, loc Location) (PrayerTimes, error) {
 	// Imsak time (10 minutes before Fajr)
 	imsakTime := normalizeHours(fajrTime - 10.0/60.0)
 
+	// Near the poles the sun may not reach an angle at all
+	for _, t := range []struct {
+		prayer Prayer
+		hours  float64
+	}{
+		{Subuh, fajrTime}, {Ashar, asrTime}, {Maghrib, maghribTime}, {Isya, ishaTime},
+	} {
+		if math.IsNaN(t.hours) {
+			return PrayerTimes{}, i18n.Errorf("%w: %s di lintang %.4f pada %s", ErrUndefined, t.prayer.Name(i18n.Current()), loc.Latitude, date.Format("2006-01-02"))
+		}
+	}
+
 	// Convert hours to time.Time
 	baseDate := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
 	convertHoursToTime := func(hours float64) time.Time {
@@ -217,108 +343,80 @@ func TimesForDate(date time.Time, loc Location) (PrayerTimes, error) {
 		s := int(((hours-float64(h))*60.0 - float64(m)) * 60.0)
 		return baseDate.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second)
 	}
+	adjusted := func(hours float64, minutes int) time.Time {
+		return convertHoursToTime(hours).Add(time.Duration(minutes) * time.Minute)
+	}
 
+	adj := loc.Adjustments
 	return PrayerTimes{
-		Imsak:   convertHoursToTime(imsakTime),
-		Subuh:   convertHoursToTime(fajrTime),
-		Dzuhur:  convertHoursToTime(dhuhrTime),
-		Ashar:   convertHoursToTime(asrTime),
-		Maghrib: convertHoursToTime(maghribTime),
-		Isya:    convertHoursToTime(ishaTime),
+		Imsak:   adjusted(imsakTime, adj.Imsak),
+		Subuh:   adjusted(fajrTime, adj.Subuh),
+		Dzuhur:  adjusted(dhuhrTime, adj.Dzuhur),
+		Ashar:   adjusted(asrTime, adj.Ashar),
+		Maghrib: adjusted(maghribTime, adj.Maghrib),
+		Isya:    adjusted(ishaTime, adj.Isya),
 	}, nil
 }
 
-// GetCurrentPrayer returns the current active prayer time
-func GetCurrentPrayer(t time.Time, times PrayerTimes) (string, bool) {
-	// Define prayer times with their start and end times
-	prayerPeriods := []struct {
-		name      string
-		startTime time.Time
-		endTime   time.Time
-	}{
-		{"Imsak", times.Imsak, times.Subuh},
-		{"Subuh", times.Subuh, times.Dzuhur},
-		{"Dzuhur", times.Dzuhur, times.Ashar},
-		{"Ashar", times.Ashar, times.Maghrib},
-		{"Maghrib", times.Maghrib, times.Isya},
-		{"Isya", times.Isya, times.Imsak.Add(24 * time.Hour)},
-	}
-
-	// Check if current time is within any prayer period
-	for _, period := range prayerPeriods {
-		if (t.Equal(period.startTime) || t.After(period.startTime)) && t.Before(period.endTime) {
-			return period.name, true
+// GetCurrentPrayer returns the prayer whose time has started and not yet
+// ended at t; each prayer lasts until the next one, Isya until tomorrow's
+// Imsak. It reports false before today's Imsak.
+func GetCurrentPrayer(t time.Time, times PrayerTimes) (PrayerTime, bool) {
+	all := times.All()
+	for i, current := range all {
+		end := times.Imsak.Add(24 * time.Hour)
+		if i+1 < len(all) {
+			end = all[i+1].Time
+		}
+		if !t.Before(current.Time) && t.Before(end) {
+			return current, true
 		}
 	}
 
-	return "", false
+	return PrayerTime{}, false
 }
 
-// GetNextPrayer returns the next prayer time after the given time
-// GetPrayerEmoji returns an emoji for each prayer time
-func GetPrayerEmoji(prayerName string) string {
-	switch prayerName {
-	case "Imsak":
-		return "🌙 " // Bulan - masih malam
-	case "Subuh":
-		return "🌅 " // Matahari terbit
-	case "Dzuhur":
-		return "☀️ " // Matahari penuh
-	case "Ashar":
-		return "🌤️ " // Matahari dengan awan
-	case "Maghrib":
-		return "🌇 " // Matahari terbenam
-	case "Isya":
-		return "✨ " // Bintang - malam
-	default:
-		return ""
-	}
-}
-
-func GetNextPrayer(t time.Time, times PrayerTimes) (string, time.Time) {
+// GetNextPrayer returns the first prayer after t, which is tomorrow's first
+// prayer once all of today's have passed
+func GetNextPrayer(t time.Time, times PrayerTimes) PrayerTime {
 	// Pastikan waktu sholat diurutkan berdasarkan waktu
-	prayerTimes := []struct {
-		name string
-		time time.Time
-	}{
-		{"Imsak", times.Imsak},
-		{"Subuh", times.Subuh},
-		{"Dzuhur", times.Dzuhur},
-		{"Ashar", times.Ashar},
-		{"Maghrib", times.Maghrib},
-		{"Isya", times.Isya},
-	}
-
-	// Urutkan waktu sholat berdasarkan waktu
-	for i := 0; i < len(prayerTimes)-1; i++ {
-		for j := i + 1; j < len(prayerTimes); j++ {
-			if prayerTimes[i].time.After(prayerTimes[j].time) {
-				prayerTimes[i], prayerTimes[j] = prayerTimes[j], prayerTimes[i]
-			}
-		}
-	}
+	prayerTimes := times.All()
+	sort.SliceStable(prayerTimes, func(i, j int) bool {
+		return prayerTimes[i].Time.Before(prayerTimes[j].Time)
+	})
 
 	// Cek waktu sholat berikutnya
 	for _, prayer := range prayerTimes {
-		if prayer.time.After(t) {
-			return prayer.name, prayer.time
+		if prayer.Time.After(t) {
+			return prayer
 		}
 	}
 
 	// Jika tidak ada waktu sholat yang tersisa di hari ini, kembalikan waktu sholat pertama untuk hari berikutnya
 	// Biasanya ini adalah Imsak atau Subuh
 	nextDayTime := t.Add(24 * time.Hour)
-	if len(prayerTimes) > 0 {
-		// Ambil waktu sholat pertama (setelah diurutkan)
-		firstPrayer := prayerTimes[0]
-		nextTime := time.Date(
+	first := prayerTimes[0]
+	return PrayerTime{
+		Prayer: first.Prayer,
+		Time: time.Date(
 			nextDayTime.Year(), nextDayTime.Month(), nextDayTime.Day(),
-			firstPrayer.time.Hour(), firstPrayer.time.Minute(), firstPrayer.time.Second(), 0,
+			first.Time.Hour(), first.Time.Minute(), first.Time.Second(), 0,
 			t.Location(),
-		)
-		return firstPrayer.name + " (besok)", nextTime
+		),
+		DayOffset: 1,
 	}
+}
 
-	// Fallback jika tidak ada waktu sholat yang tersedia
-	return "Subuh (besok)", times.Subuh.Add(24 * time.Hour)
+// GetPreviousPrayer returns the prayer before next, as returned by
+// GetNextPrayer: today's last prayer for tomorrow's first one. It reports
+// false when next is the first prayer of the day.
+func GetPreviousPrayer(next PrayerTime, times PrayerTimes) (PrayerTime, bool) {
+	if next.DayOffset == 1 {
+		return PrayerTime{Prayer: Isya, Time: times.Isya}, true
+	}
+	if next.Prayer == Imsak {
+		return PrayerTime{}, false
+	}
+	prev := next.Prayer - 1
+	return PrayerTime{Prayer: prev, Time: times.Time(prev)}, true
 }
@@ -0,0 +1,58 @@
+package salat
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEquationOfTime(t *testing.T) {
+	// Published values of the equation of time, in minutes
+	tests := []struct {
+		date string
+		want float64
+	}{
+		{"2024-02-11", -14.2},
+		{"2024-05-14", 3.7},
+		{"2024-07-26", -6.5},
+		{"2024-11-03", 16.4},
+	}
+	for _, tt := range tests {
+		date, err := time.Parse("2006-01-02", tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, got := calculateSolarPosition(calculateJulianDate(date.Add(12 * time.Hour)))
+		if math.Abs(got-tt.want) > 0.5 {
+			t.Errorf("%s: equation of time = %.1f min, want %.1f", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestTimesForDateJakarta(t *testing.T) {
+	// The Kemenag schedule of Jakarta Pusat for 1 Ramadhan 1445. Kemenag
+	// adds a margin (ihtiyat) of about two minutes to its published times.
+	wib := time.FixedZone("WIB", 7*3600)
+	date := time.Date(2024, 3, 12, 0, 0, 0, 0, wib)
+	times, err := TimesForDate(date, Location{Latitude: -6.1751, Longitude: 106.8650, Method: Kemenag})
+	if err != nil {
+		t.Fatal(err)
+	}
+	published := map[Prayer]string{
+		Imsak:   "04:29",
+		Subuh:   "04:39",
+		Dzuhur:  "12:04",
+		Ashar:   "15:11",
+		Maghrib: "18:10",
+		Isya:    "19:19",
+	}
+	for _, p := range Prayers {
+		want, err := time.ParseInLocation("2006-01-02 15:04", "2024-03-12 "+published[p], wib)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := times.Time(p).Sub(want); diff < -3*time.Minute || diff > 3*time.Minute {
+			t.Errorf("%s = %s, want %s within 3 minutes", p, times.Time(p).Format("15:04"), published[p])
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package salat
+
+import (
+	"fmt"
+	"time"
+)
+
+// HijriMonths are the names of the Hijri months, as commonly written in Indonesia
+var HijriMonths = [12]string{
+	"Muharram", "Safar", "Rabiul Awal", "Rabiul Akhir", "Jumadil Awal", "Jumadil Akhir",
+	"Rajab", "Sya'ban", "Ramadhan", "Syawal", "Dzulqa'dah", "Dzulhijjah",
+}
+
+// HijriDate is a date of the Hijri calendar
+type HijriDate struct {
+	Year  int
+	Month int // 1 for Muharram
+	Day   int
+}
+
+// ToHijri converts the calendar day of t to the tabular Hijri calendar.
+// The tabular calendar can differ from the sighted or announced date by a
+// day or two, so callers may shift t to match the local calendar.
+func ToHijri(t time.Time) HijriDate {
+	// Julian day number of the Gregorian date
+	y, m, d := t.Date()
+	a := (14 - int(m)) / 12
+	yy := y + 4800 - a
+	mm := int(m) + 12*a - 3
+	jdn := d + (153*mm+2)/5 + 365*yy + yy/4 - yy/100 + yy/400 - 32045
+
+	// Tabular Islamic calendar, with the 30-year cycle of leap years
+	l := jdn - 1948440 + 10632
+	n := (l - 1) / 10631
+	l = l - 10631*n + 354
+	j := ((10985-l)/5316)*((50*l)/17719) + (l/5670)*((43*l)/15238)
+	l = l - ((30-j)/15)*((17719*j)/50) - (j/16)*((15238*j)/43) + 29
+	month := (24 * l) / 709
+	day := l - (709*month)/24
+	year := 30*n + j - 30
+
+	return HijriDate{Year: year, Month: month, Day: day}
+}
+
+// MonthName returns the name of the Hijri month
+func (h HijriDate) MonthName() string {
+	if h.Month < 1 || h.Month > 12 {
+		return ""
+	}
+	return HijriMonths[h.Month-1]
+}
+
+// String formats the date as e.g. "1 Ramadhan 1445 H"
+func (h HijriDate) String() string {
+	return fmt.Sprintf("%d %s %d H", h.Day, h.MonthName(), h.Year)
+}
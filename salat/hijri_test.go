@@ -0,0 +1,39 @@
+package salat
+
+import (
+	"testing"
+	"time"
+)
+
+func TestToHijri(t *testing.T) {
+	tests := []struct {
+		date string
+		want HijriDate
+	}{
+		{"2024-03-01", HijriDate{1445, 8, 20}},
+		{"2024-03-10", HijriDate{1445, 8, 29}},
+		{"2024-03-11", HijriDate{1445, 9, 1}},
+		{"2024-04-09", HijriDate{1445, 9, 30}},
+		{"2024-04-10", HijriDate{1445, 10, 1}},
+		{"2024-07-07", HijriDate{1445, 12, 30}}, // 1445 is a leap year
+		{"2024-07-08", HijriDate{1446, 1, 1}},
+	}
+	for _, tt := range tests {
+		date, err := time.Parse(time.DateOnly, tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := ToHijri(date); got != tt.want {
+			t.Errorf("ToHijri(%s) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestHijriString(t *testing.T) {
+	if got, want := (HijriDate{1445, 9, 1}).String(), "1 Ramadhan 1445 H"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+	if got := (HijriDate{1445, 13, 1}).MonthName(); got != "" {
+		t.Errorf("MonthName of month 13 = %q", got)
+	}
+}
@@ -0,0 +1,128 @@
+package salat
+
+import (
+	"strings"
+	"time"
+
+	"jadwalsalat/i18n"
+)
+
+// Prayer is one of the prayer times of a day. Prayers are ordered as they
+// occur, so they can be compared with <; the zero Prayer is no prayer.
+type Prayer int
+
+// The prayer times of a day, in order
+const (
+	Imsak Prayer = iota + 1
+	Subuh
+	Dzuhur
+	Ashar
+	Maghrib
+	Isya
+)
+
+// Prayers lists the prayer times of a day in order
+var Prayers = []Prayer{Imsak, Subuh, Dzuhur, Ashar, Maghrib, Isya}
+
+var prayerNames = [...]string{"", "Imsak", "Subuh", "Dzuhur", "Ashar", "Maghrib", "Isya"}
+
+var prayerEmoji = [...]string{
+	"",
+	"🌙 ",  // Bulan - masih malam
+	"🌅 ",  // Matahari terbit
+	"☀️ ", // Matahari penuh
+	"🌤️ ", // Matahari dengan awan
+	"🌇 ",  // Matahari terbenam
+	"✨ ",  // Bintang - malam
+}
+
+// ParsePrayer returns the prayer with the given Indonesian name, in any case
+func ParsePrayer(name string) (Prayer, bool) {
+	for _, p := range Prayers {
+		if strings.EqualFold(prayerNames[p], name) {
+			return p, true
+		}
+	}
+	return 0, false
+}
+
+// String returns the Indonesian name of the prayer, e.g. "Subuh"
+func (p Prayer) String() string {
+	if p < 0 || int(p) >= len(prayerNames) {
+		return ""
+	}
+	return prayerNames[p]
+}
+
+// Name returns the name of the prayer in the language
+func (p Prayer) Name(l i18n.Lang) string {
+	return l.T(p.String())
+}
+
+// Key returns the lowercase name of the prayer used in configuration keys,
+// e.g. "subuh" in adjustments.subuh
+func (p Prayer) Key() string {
+	return strings.ToLower(p.String())
+}
+
+// Emoji returns the emoji of the prayer followed by a space, since terminals
+// disagree on the width of some emoji
+func (p Prayer) Emoji() string {
+	if p < 0 || int(p) >= len(prayerEmoji) {
+		return ""
+	}
+	return prayerEmoji[p]
+}
+
+// HasAdzan reports whether the prayer is announced with an adzan; Imsak
+// only marks the end of the pre-dawn meal
+func (p Prayer) HasAdzan() bool {
+	return p > Imsak && p <= Isya
+}
+
+// PrayerTime is a prayer at a specific time
+type PrayerTime struct {
+	Prayer Prayer
+	Time   time.Time
+	// DayOffset is the day of the prayer relative to the schedule it was
+	// taken from: 0 for the same day, 1 for the next
+	DayOffset int
+}
+
+// Label returns the name of the prayer in the language, marked when it
+// falls on the next day, e.g. "Subuh (besok)"
+func (pt PrayerTime) Label(l i18n.Lang) string {
+	if pt.DayOffset == 1 {
+		return l.Sprintf("%s (besok)", pt.Prayer.Name(l))
+	}
+	return pt.Prayer.Name(l)
+}
+
+// Time returns the time of a prayer
+func (t PrayerTimes) Time(p Prayer) time.Time {
+	switch p {
+	case Imsak:
+		return t.Imsak
+	case Subuh:
+		return t.Subuh
+	case Dzuhur:
+		return t.Dzuhur
+	case Ashar:
+		return t.Ashar
+	case Maghrib:
+		return t.Maghrib
+	case Isya:
+		return t.Isya
+	default:
+		return time.Time{}
+	}
+}
+
+// All returns the prayer times of the day in order
+func (t PrayerTimes) All() []PrayerTime {
+	all := make([]PrayerTime, len(Prayers))
+	for i, p := range Prayers {
+		all[i] = PrayerTime{Prayer: p, Time: t.Time(p)}
+	}
+	return all
+}
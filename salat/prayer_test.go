@@ -0,0 +1,114 @@
+package salat
+
+import (
+	"testing"
+	"time"
+
+	"jadwalsalat/i18n"
+)
+
+// testTimes is a schedule of 1 March 2024 in Jakarta
+func testTimes(t *testing.T) (PrayerTimes, func(string) time.Time) {
+	t.Helper()
+	loc := time.FixedZone("WIB", 7*3600)
+	at := func(clock string) time.Time {
+		c, err := time.ParseInLocation("2006-01-02 15:04", "2024-03-01 "+clock, loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	return PrayerTimes{
+		Imsak:   at("04:25"),
+		Subuh:   at("04:35"),
+		Dzuhur:  at("12:05"),
+		Ashar:   at("15:15"),
+		Maghrib: at("18:10"),
+		Isya:    at("19:20"),
+	}, at
+}
+
+func TestPrayerNames(t *testing.T) {
+	if got := Dzuhur.String(); got != "Dzuhur" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := Dzuhur.Name(i18n.English); got != "Dhuhr" {
+		t.Errorf("Name(en) = %q", got)
+	}
+	if got := Isya.Key(); got != "isya" {
+		t.Errorf("Key() = %q", got)
+	}
+	if got := Prayer(0).String(); got != "" {
+		t.Errorf("zero Prayer String() = %q", got)
+	}
+	if p, ok := ParsePrayer("MAGHRIB"); !ok || p != Maghrib {
+		t.Errorf("ParsePrayer(MAGHRIB) = %v, %v", p, ok)
+	}
+	if _, ok := ParsePrayer("dhuha"); ok {
+		t.Error("ParsePrayer(dhuha) succeeded")
+	}
+	for i := 1; i < len(Prayers); i++ {
+		if !(Prayers[i-1] < Prayers[i]) {
+			t.Errorf("%v is not before %v", Prayers[i-1], Prayers[i])
+		}
+	}
+}
+
+func TestGetCurrentPrayer(t *testing.T) {
+	times, at := testTimes(t)
+	tests := []struct {
+		now  string
+		want Prayer
+		ok   bool
+	}{
+		{"03:00", 0, false},
+		{"04:25", Imsak, true},
+		{"04:35", Subuh, true},
+		{"13:00", Dzuhur, true},
+		{"23:59", Isya, true},
+	}
+	for _, tt := range tests {
+		got, ok := GetCurrentPrayer(at(tt.now), times)
+		if got.Prayer != tt.want || ok != tt.ok {
+			t.Errorf("GetCurrentPrayer(%s) = %v, %v; want %v, %v", tt.now, got.Prayer, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestGetNextPrayer(t *testing.T) {
+	times, at := testTimes(t)
+	tests := []struct {
+		now       string
+		want      Prayer
+		dayOffset int
+		label     string
+	}{
+		{"03:00", Imsak, 0, "Imsak"},
+		{"12:05", Ashar, 0, "Ashar"},
+		{"19:00", Isya, 0, "Isya"},
+		{"21:00", Imsak, 1, "Imsak (besok)"},
+	}
+	for _, tt := range tests {
+		got := GetNextPrayer(at(tt.now), times)
+		if got.Prayer != tt.want || got.DayOffset != tt.dayOffset {
+			t.Errorf("GetNextPrayer(%s) = %v +%d, want %v +%d", tt.now, got.Prayer, got.DayOffset, tt.want, tt.dayOffset)
+		}
+		if label := got.Label(i18n.Indonesian); label != tt.label {
+			t.Errorf("GetNextPrayer(%s).Label() = %q, want %q", tt.now, label, tt.label)
+		}
+	}
+
+	next := GetNextPrayer(at("21:00"), times)
+	if want := at("04:25").AddDate(0, 0, 1); !next.Time.Equal(want) {
+		t.Errorf("tomorrow's Imsak at %v, want %v", next.Time, want)
+	}
+	if prev, ok := GetPreviousPrayer(next, times); !ok || prev.Prayer != Isya {
+		t.Errorf("GetPreviousPrayer(tomorrow's Imsak) = %v, %v; want Isya", prev.Prayer, ok)
+	}
+	if prev, ok := GetPreviousPrayer(GetNextPrayer(at("13:00"), times), times); !ok || prev.Prayer != Dzuhur {
+		t.Errorf("GetPreviousPrayer(Ashar) = %v, %v; want Dzuhur", prev.Prayer, ok)
+	}
+	if _, ok := GetPreviousPrayer(GetNextPrayer(at("03:00"), times), times); ok {
+		t.Error("GetPreviousPrayer(today's Imsak) succeeded")
+	}
+}
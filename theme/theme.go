@@ -0,0 +1,225 @@
+// Package theme holds the colour themes of the terminal output. A theme
+// gives a style to each part of the output, written as words such as
+// "hi-cyan bold" or "black on-yellow".
+package theme
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"jadwalsalat/i18n"
+)
+
+// Default is the theme used when none is chosen
+const Default = "dark"
+
+// ShowDefault is the theme of 'salat show' when none is chosen. It stays
+// light, the colours show had before themes.
+const ShowDefault = "light"
+
+// Theme is the style of each part of the output. An empty style falls back
+// to the built-in theme of the same name, or to Default.
+type Theme struct {
+	// Header is the title of each screen
+	Header string `mapstructure:"header"`
+	// Active is the prayer whose time it is now
+	Active string `mapstructure:"active"`
+	// Next is the next prayer and its countdown
+	Next string `mapstructure:"next"`
+	// Passed is the prayers already passed and the empty part of progress bars
+	Passed string `mapstructure:"passed"`
+	// Progress is the filled part of progress bars and the percentage
+	Progress string `mapstructure:"progress"`
+}
+
+// Roles are the parts of a theme, as written in config.yaml
+var Roles = []string{"header", "active", "next", "passed", "progress"}
+
+// builtin are the themes shipped with salat
+var builtin = map[string]Theme{
+	"dark": {
+		Header:   "hi-cyan bold",
+		Active:   "hi-yellow bold",
+		Next:     "hi-green bold",
+		Passed:   "hi-black",
+		Progress: "hi-green",
+	},
+	// Header and next are the colours of show before themes
+	"light": {
+		Header:   "blue bold",
+		Active:   "magenta bold",
+		Next:     "yellow",
+		Passed:   "hi-black",
+		Progress: "blue",
+	},
+	"high-contrast": {
+		Header:   "hi-white bold underline",
+		Active:   "black on-hi-yellow bold",
+		Next:     "hi-yellow bold",
+		Passed:   "white",
+		Progress: "hi-white bold",
+	},
+	"mono": {
+		Header:   "bold",
+		Active:   "reverse bold",
+		Next:     "bold underline",
+		Passed:   "faint",
+		Progress: "bold",
+	},
+}
+
+// Get returns the style of a role, or false for an unknown role
+func (t Theme) Get(role string) (string, bool) {
+	switch role {
+	case "header":
+		return t.Header, true
+	case "active":
+		return t.Active, true
+	case "next":
+		return t.Next, true
+	case "passed":
+		return t.Passed, true
+	case "progress":
+		return t.Progress, true
+	}
+	return "", false
+}
+
+// Set sets the style of a role, and reports whether the role is known
+func (t *Theme) Set(role, style string) bool {
+	switch role {
+	case "header":
+		t.Header = style
+	case "active":
+		t.Active = style
+	case "next":
+		t.Next = style
+	case "passed":
+		t.Passed = style
+	case "progress":
+		t.Progress = style
+	default:
+		return false
+	}
+	return true
+}
+
+// IsZero reports whether no role has a style
+func (t Theme) IsZero() bool {
+	return t == Theme{}
+}
+
+// Names returns the names of the built-in themes and of user, sorted
+func Names(user map[string]Theme) []string {
+	names := make([]string, 0, len(builtin)+len(user))
+	for name := range builtin {
+		names = append(names, name)
+	}
+	for name := range user {
+		if _, ok := builtin[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns the theme with the given name from user or the built-in
+// themes. Roles a user theme leaves empty are taken from the built-in theme
+// of the same name, or from Default.
+func Lookup(name string, user map[string]Theme) (Theme, bool) {
+	base, isBuiltin := builtin[name]
+	t, isUser := user[name]
+	if !isBuiltin && !isUser {
+		return Theme{}, false
+	}
+	if !isBuiltin {
+		base = builtin[Default]
+	}
+	for _, role := range Roles {
+		if style, _ := t.Get(role); style == "" {
+			style, _ = base.Get(role)
+			t.Set(role, style)
+		}
+	}
+	return t, true
+}
+
+// Color is a terminal colour: 0-7 are the basic ANSI colours, 8-15 their
+// bright variants, and 16-255 the rest of the 256-colour palette
+type Color int
+
+// NoColor leaves the colour of the terminal unchanged
+const NoColor Color = -1
+
+// colorNames are the colours 0-7; a "hi-" prefix gives the bright variant
+var colorNames = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// Style is a parsed style
+type Style struct {
+	Fg, Bg    Color
+	Bold      bool
+	Faint     bool
+	Italic    bool
+	Underline bool
+	Reverse   bool
+}
+
+// ParseStyle parses a style of space-separated words: a colour name such as
+// "cyan" or "hi-cyan" (with "gray" for "hi-black"), a 256-colour number, a
+// background colour prefixed with "on-", and the attributes bold, faint,
+// italic, underline, and reverse. "none" is a style without any of these.
+func ParseStyle(s string) (Style, error) {
+	style := Style{Fg: NoColor, Bg: NoColor}
+	for _, word := range strings.Fields(strings.ToLower(s)) {
+		switch word {
+		case "none":
+		case "bold":
+			style.Bold = true
+		case "faint", "dim":
+			style.Faint = true
+		case "italic":
+			style.Italic = true
+		case "underline":
+			style.Underline = true
+		case "reverse":
+			style.Reverse = true
+		default:
+			if bg, ok := strings.CutPrefix(word, "on-"); ok {
+				c, ok := parseColor(bg)
+				if !ok {
+					return Style{}, i18n.Errorf("warna tidak dikenal %q", bg)
+				}
+				style.Bg = c
+				continue
+			}
+			c, ok := parseColor(word)
+			if !ok {
+				return Style{}, i18n.Errorf("warna atau atribut tidak dikenal %q", word)
+			}
+			style.Fg = c
+		}
+	}
+	return style, nil
+}
+
+// parseColor parses a colour name or number
+func parseColor(s string) (Color, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return Color(n), n >= 0 && n <= 255
+	}
+	if s == "gray" || s == "grey" {
+		return 8, true
+	}
+	s, bright := strings.CutPrefix(s, "hi-")
+	for i, name := range colorNames {
+		if name == s {
+			if bright {
+				return Color(i + 8), true
+			}
+			return Color(i), true
+		}
+	}
+	return NoColor, false
+}
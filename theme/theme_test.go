@@ -0,0 +1,85 @@
+package theme
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseStyle(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Style
+	}{
+		{"", Style{Fg: NoColor, Bg: NoColor}},
+		{"none", Style{Fg: NoColor, Bg: NoColor}},
+		{"cyan", Style{Fg: 6, Bg: NoColor}},
+		{"hi-cyan bold", Style{Fg: 14, Bg: NoColor, Bold: true}},
+		{"Black on-Hi-Yellow", Style{Fg: 0, Bg: 11}},
+		{"gray underline", Style{Fg: 8, Bg: NoColor, Underline: true}},
+		{"208 on-17 italic", Style{Fg: 208, Bg: 17, Italic: true}},
+		{"reverse dim", Style{Fg: NoColor, Bg: NoColor, Reverse: true, Faint: true}},
+	}
+	for _, tt := range tests {
+		got, err := ParseStyle(tt.in)
+		if err != nil {
+			t.Errorf("ParseStyle(%q): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseStyle(%q) = %+v, want %+v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{"purple", "on-bold", "256", "hi-gray"} {
+		if _, err := ParseStyle(in); err == nil {
+			t.Errorf("ParseStyle(%q) succeeded, want an error", in)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	user := map[string]Theme{
+		"senja": {Header: "hi-magenta bold"},
+		"light": {Active: "red"},
+	}
+
+	senja, ok := Lookup("senja", user)
+	if !ok {
+		t.Fatal("senja not found")
+	}
+	if senja.Header != "hi-magenta bold" || senja.Next != builtin[Default].Next {
+		t.Errorf("senja = %+v, want its header and the rest from %s", senja, Default)
+	}
+
+	light, _ := Lookup("light", user)
+	if light.Active != "red" || light.Header != builtin["light"].Header {
+		t.Errorf("light = %+v, want its active and the rest of the built-in light", light)
+	}
+
+	if _, ok := Lookup("unknown", user); ok {
+		t.Error("Lookup(unknown) succeeded")
+	}
+
+	want := []string{"dark", "high-contrast", "light", "mono", "senja"}
+	if got := Names(user); !slices.Equal(got, want) {
+		t.Errorf("Names = %v, want %v", got, want)
+	}
+
+	for _, name := range Names(nil) {
+		b := builtin[name]
+		for _, role := range Roles {
+			style, _ := b.Get(role)
+			if _, err := ParseStyle(style); err != nil {
+				t.Errorf("built-in %s.%s: %v", name, role, err)
+			}
+		}
+	}
+}
+
+func TestShowDefault(t *testing.T) {
+	// show keeps the colours it had before themes
+	light, ok := Lookup(ShowDefault, nil)
+	if !ok || light.Header != "blue bold" || light.Next != "yellow" {
+		t.Errorf("%s = %+v, want the blue header and yellow next of show", ShowDefault, light)
+	}
+}